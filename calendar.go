@@ -0,0 +1,113 @@
+package humanize
+
+// Calendar timestamps humanization functions.
+
+import (
+	"fmt"
+	"golang.org/x/text/language"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HourCycle selects the clock used in calendar timestamps.
+type HourCycle int
+
+const (
+	HourCycleDefault HourCycle = iota // Clock of the humanizer's region or, if unknown, of the language.
+	HourCycle12                       // E.g. "2:05 PM".
+	HourCycle24                       // E.g. "14:05".
+)
+
+// CalendarOptions control the cut-offs and the clock of calendar timestamps.
+type CalendarOptions struct {
+	// Number of days from the reference date, up to 6, humanized as relative days with the time, e.g. "Yesterday at
+	// 9:30". Zero means 6, negative disables the relative days.
+	RelativeDays int
+	// Dates closer to the reference date than this are humanized with TimeDiff, e.g. "5 minutes ago". Zero disables.
+	Recent time.Duration
+	// Clock used for the time.
+	HourCycle HourCycle
+	// Location of the calendar days. Nil means the location of the reference date.
+	Location *time.Location
+}
+
+// Regions using the 12-hour clock.
+var hour12Regions = map[string]bool{
+	"US": true, "CA": true, "AU": true, "NZ": true, "IN": true, "PH": true, "PK": true, "BD": true, "EG": true,
+	"SA": true, "KR": true, "TW": true, "MY": true, "CO": true, "MX": true,
+}
+
+// CalendarTimeNow is a convenience method returning the calendar timestamp of the date relative to now.
+func (humanizer *Humanizer) CalendarTimeNow(date time.Time, options CalendarOptions) string {
+	return humanizer.CalendarTime(humanizer.clock.Now(), date, options)
+}
+
+// CalendarTime will return the chat-style timestamp of the date, relative to the reference date, e.g.:
+//
+//	"Today at 14:05", "Last Friday at 9:30 AM", "Mar 3", "Mar 3, 2016"
+//
+// Languages without calendar formats, see Language.Times.Calendar, use the numeric "2006-01-02 15:04" format for all
+// the dates, also the relative days. Of the built-in languages only English and Polish have calendar formats.
+func (humanizer *Humanizer) CalendarTime(reference, date time.Time, options CalendarOptions) string {
+	if options.Recent > 0 {
+		if diff := date.Sub(reference); diff > -options.Recent && diff < options.Recent {
+			return humanizer.TimeDiff(reference, date, false)
+		}
+	}
+	loc := options.Location
+	if loc == nil {
+		loc = reference.Location()
+	}
+	reference, date = reference.In(loc), date.In(loc)
+	formats := humanizer.provider.times.calendar
+	if formats == nil {
+		return date.Format("2006-01-02 15:04")
+	}
+
+	relativeDays := options.RelativeDays
+	if relativeDays == 0 || relativeDays > 6 {
+		relativeDays = 6
+	}
+	days := daysBetween(civilDay(reference), civilDay(date))
+	if words := humanizer.provider.times.relative; words != nil && days >= -relativeDays && days <= relativeDays {
+		return capitalize(fmt.Sprintf(formats.at, words.day(days, date.Weekday()),
+			humanizer.clockTime(date, formats, options.HourCycle)))
+	}
+	format := formats.otherYear
+	if date.Year() == reference.Year() {
+		format = formats.sameYear
+	}
+	return fmt.Sprintf(format, date.Day(), formats.months[date.Month()-1], date.Year())
+}
+
+// clockTime returns the time of the date in the 12-hour or 24-hour clock.
+func (humanizer *Humanizer) clockTime(date time.Time, formats *calendarFormats, cycle HourCycle) string {
+	if cycle == HourCycleDefault {
+		cycle = HourCycle24
+		if humanizer.uses12HourClock(formats) {
+			cycle = HourCycle12
+		}
+	}
+	if cycle == HourCycle24 {
+		return date.Format("15:04")
+	}
+	return date.Format("3:04") + " " + formats.dayPeriods[date.Hour()/12]
+}
+
+// uses12HourClock checks whether the humanizer's region, if given explicitly, or its language uses the 12-hour clock.
+func (humanizer *Humanizer) uses12HourClock(formats *calendarFormats) bool {
+	if region, confidence := humanizer.tag.Region(); confidence == language.Exact {
+		return hour12Regions[region.String()]
+	}
+	return formats.hour12
+}
+
+// capitalize returns the string with the first letter in upper case.
+func capitalize(text string) string {
+	if text == "" {
+		return text
+	}
+	first, size := utf8.DecodeRuneInString(text)
+	return string(unicode.ToUpper(first)) + text[size:]
+}
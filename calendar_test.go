@@ -0,0 +1,106 @@
+package humanize
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanizer_CalendarTime(t *testing.T) {
+	// Wednesday.
+	reference := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+	cases := map[string]map[time.Time]string{
+		"en": {
+			time.Date(2017, 3, 15, 14, 5, 0, 0, time.UTC):  "Today at 2:05 PM",
+			time.Date(2017, 3, 14, 0, 30, 0, 0, time.UTC):  "Yesterday at 12:30 AM",
+			time.Date(2017, 3, 10, 9, 30, 0, 0, time.UTC):  "Last Friday at 9:30 AM",
+			time.Date(2017, 3, 21, 12, 0, 0, 0, time.UTC):  "Next Tuesday at 12:00 PM",
+			time.Date(2017, 3, 3, 12, 0, 0, 0, time.UTC):   "Mar 3",
+			time.Date(2016, 3, 3, 12, 0, 0, 0, time.UTC):   "Mar 3, 2016",
+			time.Date(2018, 12, 24, 12, 0, 0, 0, time.UTC): "Dec 24, 2018",
+		},
+		"en-GB": {
+			time.Date(2017, 3, 15, 14, 5, 0, 0, time.UTC): "Today at 14:05",
+		},
+		"pl": {
+			time.Date(2017, 3, 15, 14, 5, 0, 0, time.UTC): "Dzisiaj o 14:05",
+			time.Date(2017, 3, 10, 9, 30, 0, 0, time.UTC): "W zeszły piątek o 09:30",
+			time.Date(2017, 10, 3, 12, 0, 0, 0, time.UTC): "3 paź",
+			time.Date(2016, 3, 3, 12, 0, 0, 0, time.UTC):  "3 mar 2016",
+		},
+		// Languages without calendar formats use the numeric format.
+		"de": {
+			time.Date(2017, 3, 14, 9, 30, 0, 0, time.UTC): "2017-03-14 09:30",
+		},
+	}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for date, expected := range caseList {
+			if humanized := humanizer.CalendarTime(reference, date, CalendarOptions{}); humanized != expected {
+				t.Errorf("%s: expected '%s' for %s, got '%s'.", lang, expected, date, humanized)
+			}
+		}
+	}
+}
+
+func TestHumanizer_CalendarTime_NumericFallback(t *testing.T) {
+	reference := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+	withFormats := map[string]bool{"en": true, "pl": true}
+	for lang := range languages {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		if hasFormats := humanizer.provider.times.calendar != nil; hasFormats != withFormats[lang] {
+			t.Errorf("%s: expected calendar formats %t, got %t.", lang, withFormats[lang], hasFormats)
+			continue
+		}
+		if withFormats[lang] {
+			continue
+		}
+		// Relative days and other dates alike.
+		for date, expected := range map[time.Time]string{
+			reference.Add(4 * time.Hour):                  "2017-03-15 14:00",
+			time.Date(2017, 3, 14, 9, 30, 0, 0, time.UTC): "2017-03-14 09:30",
+			time.Date(2016, 12, 3, 18, 0, 0, 0, time.UTC): "2016-12-03 18:00",
+		} {
+			if humanized := humanizer.CalendarTime(reference, date, CalendarOptions{}); humanized != expected {
+				t.Errorf("%s: expected '%s', got '%s'.", lang, expected, humanized)
+			}
+		}
+	}
+}
+
+func TestHumanizer_CalendarTime_Options(t *testing.T) {
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	reference := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+	warsaw, err := time.LoadLocation("Europe/Warsaw")
+	if err != nil {
+		t.Skipf("Time zone data not available: %s", err)
+	}
+	cases := []struct {
+		date     time.Time
+		options  CalendarOptions
+		expected string
+	}{
+		{reference.Add(-5 * time.Minute), CalendarOptions{Recent: time.Hour}, "5 minutes ago"},
+		{reference.Add(-2 * time.Hour), CalendarOptions{Recent: time.Hour}, "Today at 8:00 AM"},
+		{reference.Add(4 * time.Hour), CalendarOptions{HourCycle: HourCycle24}, "Today at 14:00"},
+		{reference.AddDate(0, 0, -1), CalendarOptions{RelativeDays: 1}, "Yesterday at 10:00 AM"},
+		{reference.AddDate(0, 0, -2), CalendarOptions{RelativeDays: 1}, "Mar 13"},
+		{reference, CalendarOptions{RelativeDays: -1}, "Mar 15"},
+		{reference.Add(14 * time.Hour), CalendarOptions{Location: warsaw}, "Tomorrow at 1:00 AM"},
+	}
+
+	for _, testCase := range cases {
+		if humanized := humanizer.CalendarTime(reference, testCase.date, testCase.options); humanized != testCase.expected {
+			t.Errorf("Expected '%s', got '%s'.", testCase.expected, humanized)
+		}
+	}
+}
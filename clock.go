@@ -0,0 +1,160 @@
+package humanize
+
+// Clock and live time difference functions.
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock tells the current time and waits for it to pass. It can be replaced to control the time, e.g. in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel receiving the current time once the duration has passed.
+	After(duration time.Duration) <-chan time.Time
+}
+
+// systemClock is the Clock of the system, used when no other clock is given.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(duration time.Duration) <-chan time.Time {
+	return time.After(duration)
+}
+
+// FakeClock is a Clock that only moves when told to, for deterministic results in tests.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+// Channel waiting for the fake time to reach the deadline.
+type fakeWaiter struct {
+	deadline time.Time
+	fired    chan time.Time
+}
+
+// NewFakeClock creates a fake clock stopped at the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the current fake time.
+func (clock *FakeClock) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return clock.now
+}
+
+// After returns a channel receiving the fake time once it is moved by at least the duration.
+func (clock *FakeClock) After(duration time.Duration) <-chan time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	waiter := fakeWaiter{deadline: clock.now.Add(duration), fired: make(chan time.Time, 1)}
+	if duration <= 0 {
+		waiter.fired <- clock.now
+	} else {
+		clock.waiters = append(clock.waiters, waiter)
+	}
+	return waiter.fired
+}
+
+// Advance moves the fake time forward by the duration.
+func (clock *FakeClock) Advance(duration time.Duration) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.set(clock.now.Add(duration))
+}
+
+// Set moves the fake time to the given time. Moving it backwards does not fire any waiting channels.
+func (clock *FakeClock) Set(now time.Time) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.set(now)
+}
+
+// set moves the time and fires the channels that reached their deadline. Lock must be held.
+func (clock *FakeClock) set(now time.Time) {
+	clock.now = now
+	waiting := clock.waiters[:0]
+	for _, waiter := range clock.waiters {
+		if waiter.deadline.After(now) {
+			waiting = append(waiting, waiter)
+		} else {
+			waiter.fired <- now
+		}
+	}
+	clock.waiters = waiting
+}
+
+// Longest time searched for the next change of a time difference, about 136 years.
+const maxNextChange = 1 << 32 * time.Second
+
+// TimeDiffNext will return the humanized time difference, as returned by TimeDiff, and the time at which the
+// reference date has to arrive for it to change, e.g. "5 minutes ago" changes into "6 minutes ago".
+// Zero time is returned if the difference does not change anymore.
+func (humanizer *Humanizer) TimeDiffNext(reference, date time.Time, precise bool) (string, time.Time) {
+	label := humanizer.TimeDiff(reference, date, precise)
+	changed := func(after time.Duration) bool {
+		return humanizer.TimeDiff(reference.Add(after), date, precise) != label
+	}
+	// Labels are the same in whole intervals of time, so the first change is found by a binary search.
+	low, high := time.Duration(0), time.Nanosecond
+	for !changed(high) {
+		if high >= maxNextChange {
+			return label, time.Time{}
+		}
+		low, high = high, 2*high
+	}
+	for high-low > time.Nanosecond {
+		middle := low + (high-low)/2
+		if changed(middle) {
+			high = middle
+		} else {
+			low = middle
+		}
+	}
+	return label, reference.Add(high)
+}
+
+// WatchTimeDiff will send the humanized time difference between the current time and the date on the returned channel,
+// and then every time it changes, until the context is done. Labels are only computed when they change, so that a UI
+// can refresh exactly when needed. Time is read from the clock of the humanizer, see WithClock.
+// Precise labels show the seconds, so they change every second.
+func (humanizer *Humanizer) WatchTimeDiff(ctx context.Context, date time.Time, precise bool) <-chan string {
+	clock := humanizer.clock
+	labels := make(chan string)
+	go func() {
+		defer close(labels)
+		previous := ""
+		for {
+			label, next := humanizer.TimeDiffNext(clock.Now(), date, precise)
+			// Clocks can wake up early, so the label is only sent when it has changed.
+			if label != previous {
+				select {
+				case labels <- label:
+					previous = label
+				case <-ctx.Done():
+					return
+				}
+			}
+			if next.IsZero() {
+				<-ctx.Done()
+				return
+			}
+			select {
+			case <-clock.After(next.Sub(clock.Now())):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return labels
+}
@@ -0,0 +1,169 @@
+package humanize
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHumanizer_TimeDiffNext(t *testing.T) {
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	reference := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+	cases := []struct {
+		date    time.Time
+		precise bool
+		label   string
+		next    time.Time
+	}{
+		{reference.Add(-5*time.Minute - 30*time.Second), false, "5 minutes ago", reference.Add(30 * time.Second)},
+		{reference.Add(5*time.Minute + 30*time.Second), false, "in 5 minutes", reference.Add(30*time.Second + 1)},
+		{reference.Add(-23 * time.Hour), false, "23 hours ago", reference.Add(time.Hour)},
+		{reference.Add(2 * time.Second), false, "in 2 seconds", reference.Add(1)},
+		{reference, false, "now", reference.Add(1)},
+		{reference.Add(-300 * time.Millisecond), false, "300 milliseconds ago", reference.Add(time.Millisecond)},
+		{reference.Add(-2 * time.Hour), true, "2 hours ago", reference.Add(time.Second)},
+		{reference.Add(-2*time.Hour - 999*time.Millisecond), true, "2 hours ago", reference.Add(time.Millisecond)},
+		{reference.Add(-999 * time.Millisecond), false, "999 milliseconds ago", reference.Add(time.Millisecond)},
+		{reference.AddDate(0, 0, -10), false, "1 week ago", reference.AddDate(0, 0, 4)},
+	}
+
+	for _, testCase := range cases {
+		label, next := humanizer.TimeDiffNext(reference, testCase.date, testCase.precise)
+		if label != testCase.label || !next.Equal(testCase.next) {
+			t.Errorf("Expected '%s' until %s, got '%s' until %s.", testCase.label, testCase.next, label, next)
+		}
+		if changed := humanizer.TimeDiff(next, testCase.date, testCase.precise); changed == label {
+			t.Errorf("Expected a change of '%s' at %s.", label, next)
+		}
+	}
+}
+
+// steppingClock is a clock moving forward whenever it is waited for.
+type steppingClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (clock *steppingClock) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return clock.now
+}
+
+func (clock *steppingClock) After(duration time.Duration) <-chan time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.now = clock.now.Add(duration)
+	fired := make(chan time.Time, 1)
+	fired <- clock.now
+	return fired
+}
+
+func TestHumanizer_WatchTimeDiff(t *testing.T) {
+	clock := &steppingClock{now: time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)}
+	humanizer, err := New("en", WithClock(clock))
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	date := clock.now.Add(-58*time.Minute - 30*time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	labels := humanizer.WatchTimeDiff(ctx, date, false)
+	for _, expected := range []string{"58 minutes ago", "59 minutes ago", "1 hour ago", "2 hours ago"} {
+		if label := <-labels; label != expected {
+			t.Errorf("Expected '%s', got '%s'.", expected, label)
+		}
+	}
+	cancel()
+	for range labels {
+		// Drain the channel, until it is closed.
+	}
+}
+
+func TestHumanizer_WithClock(t *testing.T) {
+	clock := NewFakeClock(time.Date(2017, 3, 15, 23, 59, 59, 900, time.UTC))
+	humanizer, err := New("en", WithClock(clock))
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	date := time.Date(2017, 3, 15, 23, 54, 59, 0, time.UTC)
+	cases := []struct {
+		name, humanized, expected string
+	}{
+		{"TimeDiffNow", humanizer.TimeDiffNow(date, false), "5 minutes ago"},
+		{"TimeDiffNowWith", humanizer.TimeDiffNowWith(date, DurationOptions{}), "5 minutes ago"},
+		{"RelativeDateNow", humanizer.RelativeDateNow(date.Add(time.Hour), time.UTC), "tomorrow"},
+		{"CalendarTimeNow", humanizer.CalendarTimeNow(date, CalendarOptions{}), "Today at 11:54 PM"},
+	}
+	for _, testCase := range cases {
+		if testCase.humanized != testCase.expected {
+			t.Errorf("%s: Expected '%s', got '%s'.", testCase.name, testCase.expected, testCase.humanized)
+		}
+	}
+
+	humanizer, err = NewFromAcceptLanguage("pl-PL", WithClock(clock))
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	if humanized := humanizer.TimeDiffNow(date, false); humanized != "5 minut temu" {
+		t.Errorf("Expected '5 minut temu', got '%s'.", humanized)
+	}
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	soon, later := clock.After(time.Minute), clock.After(time.Hour)
+	select {
+	case <-clock.After(0):
+	default:
+		t.Errorf("Expected the channel to fire immediately.")
+	}
+
+	clock.Advance(59 * time.Second)
+	select {
+	case <-soon:
+		t.Errorf("Expected the channel to wait for a minute.")
+	default:
+	}
+	clock.Advance(time.Second)
+	if fired := <-soon; !fired.Equal(start.Add(time.Minute)) {
+		t.Errorf("Expected '%s', got '%s'.", start.Add(time.Minute), fired)
+	}
+
+	clock.Set(start.Add(2 * time.Hour))
+	if fired := <-later; !fired.Equal(start.Add(2 * time.Hour)) {
+		t.Errorf("Expected '%s', got '%s'.", start.Add(2*time.Hour), fired)
+	}
+	if now := clock.Now(); !now.Equal(start.Add(2 * time.Hour)) {
+		t.Errorf("Expected '%s', got '%s'.", start.Add(2*time.Hour), now)
+	}
+}
+
+func TestHumanizer_WatchTimeDiff_FakeClock(t *testing.T) {
+	clock := NewFakeClock(time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC))
+	humanizer, err := New("en", WithClock(clock))
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	labels := humanizer.WatchTimeDiff(ctx, clock.Now().Add(-10*time.Second), false)
+	if label := <-labels; label != "10 seconds ago" {
+		t.Errorf("Expected '10 seconds ago', got '%s'.", label)
+	}
+	clock.Advance(5 * time.Second)
+	if label := <-labels; label != "15 seconds ago" {
+		t.Errorf("Expected '15 seconds ago', got '%s'.", label)
+	}
+	cancel()
+	for range labels {
+		// Drain the channel, until it is closed.
+	}
+}
@@ -2,4 +2,8 @@ module github.com/pawelszydlo/humanize
 
 go 1.25.6
 
-require golang.org/x/text v0.34.0
+require (
+	github.com/BurntSushi/toml v1.6.0
+	golang.org/x/text v0.34.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -6,28 +6,111 @@ import (
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
 	"regexp"
+	"sort"
+	"strings"
 )
 
 // Humanizer is the main struct that provides the public methods.
 type Humanizer struct {
 	provider      languageProvider
+	tag           language.Tag // Tag the humanizer was created for, including the region.
 	printer       *message.Printer
 	timeInputRe   *regexp.Regexp
+	timeUnits     []string // Input time units, longest first.
 	prefixInputRe *regexp.Regexp
+	groupSymbol   string      // Symbol grouping the digits of the locale, e.g. "," in "1,024".
 	allPrefixes   []prefixDef // Helper slice of all prefixes.
+	clock         Clock       // Source of the current time for the "Now" functions.
+}
+
+// Option configures the humanizer at creation.
+type Option func(*Humanizer)
+
+// WithClock sets the clock used for the current time by TimeDiffNow and the other "Now" functions.
+// The default is the system clock, a FakeClock makes the results deterministic, e.g. in tests.
+func WithClock(clock Clock) Option {
+	return func(humanizer *Humanizer) {
+		if clock != nil {
+			humanizer.clock = clock
+		}
+	}
 }
 
 // New creates a new humanizer for a given language.
-func New(langName string) (*Humanizer, error) {
-	if provider, exists := languages[langName]; exists {
-		humanizer := &Humanizer{
-			provider:    provider,
-			printer:     message.NewPrinter(language.MustParse(langName)),
-			allPrefixes: make([]prefixDef, len(siPrefixes)+len(bitPrefixes)),
+// Regional variants (e.g. "en-GB" or "pl_PL") fall back to their base language, while keeping the regional
+// number formatting. Related languages and other scripts are not substituted, e.g. "zh-Hant" is not supported.
+func New(langName string, options ...Option) (*Humanizer, error) {
+	tag, err := language.Parse(strings.Replace(langName, "_", "-", -1))
+	if err != nil {
+		return nil, fmt.Errorf("language not supported: %s", langName)
+	}
+	provider, exists := matchLanguage(tag)
+	if !exists {
+		return nil, fmt.Errorf("language not supported: %s", langName)
+	}
+	return newHumanizer(provider, tag, options), nil
+}
+
+// NewFromAcceptLanguage creates a new humanizer for the best supported language from an Accept-Language header,
+// e.g. "pl-PL,pl;q=0.9,en;q=0.8".
+func NewFromAcceptLanguage(header string, options ...Option) (*Humanizer, error) {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %q: %s", header, err)
+	}
+	// Tags are sorted by quality, pick the first one that is supported.
+	for _, tag := range tags {
+		if provider, exists := matchLanguage(tag); exists {
+			return newHumanizer(provider, tag, options), nil
 		}
-		humanizer.buildTimeInputRe()
-		humanizer.preparePrefixes()
-		return humanizer, nil
 	}
-	return nil, fmt.Errorf("language not supported: %s", langName)
+	return nil, fmt.Errorf("no supported language in %q", header)
+}
+
+// newHumanizer creates a humanizer for the language, with number formatting of the given tag.
+func newHumanizer(provider languageProvider, tag language.Tag, options []Option) *Humanizer {
+	humanizer := &Humanizer{
+		provider:    provider,
+		tag:         tag,
+		printer:     message.NewPrinter(tag),
+		allPrefixes: make([]prefixDef, len(siPrefixes)+len(bitPrefixes)),
+		clock:       systemClock{},
+	}
+	for _, option := range options {
+		option(humanizer)
+	}
+	humanizer.buildTimeInputRe()
+	humanizer.preparePrefixes()
+	return humanizer
+}
+
+// matchLanguage finds the registered language best matching the tag.
+func matchLanguage(tag language.Tag) (languageProvider, bool) {
+	languagesMu.RLock()
+	defer languagesMu.RUnlock()
+	// Sort the names, so that the matching is deterministic.
+	names := make([]string, 0, len(languages))
+	for name := range languages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	supported := make([]language.Tag, len(names))
+	for i, name := range names {
+		supported[i] = language.Make(name)
+	}
+	_, index, confidence := language.NewMatcher(supported).Match(tag)
+	if confidence == language.No || !sameLanguage(tag, supported[index]) {
+		return languageProvider{}, false
+	}
+	return languages[names[index]], true
+}
+
+// sameLanguage checks whether the tags are variants of the same language, written in the same script. The matcher
+// also accepts related languages, e.g. Russian for Belarusian, or Simplified Chinese for "zh-Hant".
+func sameLanguage(tag, supported language.Tag) bool {
+	base, _ := tag.Base()
+	supportedBase, _ := supported.Base()
+	script, _ := tag.Script()
+	supportedScript, _ := supported.Script()
+	return base == supportedBase && script == supportedScript
 }
@@ -1,6 +1,9 @@
 package humanize
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestNew_Correct(t *testing.T) {
 	var humanizer *Humanizer
@@ -22,3 +25,66 @@ func TestNew_Wrong(t *testing.T) {
 		t.Error("Humanizer creation succeeded where it should have failed.")
 	}
 }
+
+func TestNew_Regional(t *testing.T) {
+	cases := map[string]struct {
+		now    string
+		number string
+	}{
+		"en-GB": {"now", "1,234,567.5"},
+		"en_US": {"now", "1,234,567.5"},
+		"en-IN": {"now", "12,34,567.5"},
+		"pl-PL": {"teraz", "1\u00a0234\u00a0567,5"},
+		"PL":    {"teraz", "1\u00a0234\u00a0567,5"},
+	}
+
+	for langName, expected := range cases {
+		humanizer, err := New(langName)
+		if err != nil {
+			t.Errorf("Humanizer creation for %q failed with error: %s", langName, err)
+			continue
+		}
+		if humanized := humanizer.TimeDiff(time.Time{}, time.Time{}, false); humanized != expected.now {
+			t.Errorf("Expected '%s', got '%s'.", expected.now, humanized)
+		}
+		if humanized := humanizer.HumanizeNumber(1234567.5, 1); humanized != expected.number {
+			t.Errorf("Expected '%s', got '%s'.", expected.number, humanized)
+		}
+	}
+
+	// Related languages and other scripts are not substituted.
+	for _, langName := range []string{"sv-SE", "en--", "", "be", "kk", "eu", "lb", "eo", "zh-Hant", "zh-TW"} {
+		if _, err := New(langName); err == nil {
+			t.Errorf("Humanizer creation for %q succeeded where it should have failed.", langName)
+		}
+	}
+}
+
+func TestNewFromAcceptLanguage(t *testing.T) {
+	cases := map[string]string{
+		"pl-PL,pl;q=0.9,en;q=0.8": "teraz",
+		"sv-SE, en-GB;q=0.8":      "now",
+		"de-CH, en-GB;q=0.8":      "jetzt",
+		"fr;q=0.5, pl;q=0.7, en":  "now",
+		"*;q=0.1, pl":             "teraz",
+		"be-BY, uk;q=0.5":         "зараз",
+		"zh-Hant, zh-CN;q=0.5":    "现在",
+	}
+
+	for header, expected := range cases {
+		humanizer, err := NewFromAcceptLanguage(header)
+		if err != nil {
+			t.Errorf("Humanizer creation for %q failed with error: %s", header, err)
+			continue
+		}
+		if humanized := humanizer.TimeDiff(time.Time{}, time.Time{}, false); humanized != expected {
+			t.Errorf("Expected '%s', got '%s'.", expected, humanized)
+		}
+	}
+
+	for _, header := range []string{"sv-SE, fi;q=0.5", "en;q=abc"} {
+		if _, err := NewFromAcceptLanguage(header); err == nil {
+			t.Errorf("Humanizer creation for %q succeeded where it should have failed.", header)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package humanize
+
+// ISO 8601 durations formatting and parsing functions.
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ISO8601 is a duration in the ISO 8601 format, e.g. "P1Y2M10DT2H30M". Years, months, weeks and days are nominal,
+// i.e. their length depends on the date they are added to.
+type ISO8601 struct {
+	Negative                   bool
+	Years, Months, Weeks, Days int
+	Time                       time.Duration // Hours, minutes and seconds.
+}
+
+// Regular expression matching the ISO 8601 durations. Only the time components can have a fraction.
+var iso8601Re = regexp.MustCompile(`(?i)^([-+])?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?` +
+	`(T(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// FormatISO8601 will return the duration in the ISO 8601 format, e.g. "PT2H30M". Hours are not converted into days,
+// as days are nominal.
+func FormatISO8601(duration time.Duration) string {
+	if duration < 0 {
+		return ISO8601{Negative: true, Time: -duration}.String()
+	}
+	return ISO8601{Time: duration}.String()
+}
+
+// ParseISO8601 will parse the duration in the ISO 8601 format, e.g. "P1Y2M10DT2H30M" or "PT0.5S".
+// A leading sign is accepted as well, e.g. "-P1D".
+func ParseISO8601(input string) (ISO8601, error) {
+	if input == "" {
+		return ISO8601{}, &ParseError{Input: input, Err: ErrEmptyInput}
+	}
+	bounds := iso8601Re.FindStringSubmatchIndex(input)
+	group := func(i int) string {
+		if bounds[2*i] < 0 {
+			return ""
+		}
+		return input[bounds[2*i]:bounds[2*i+1]]
+	}
+	// 1 - sign, 2..5 - years, months, weeks, days, 6 - time, 7..9 - hours, minutes, seconds
+	if bounds == nil || group(6) == "T" || group(2)+group(3)+group(4)+group(5)+group(6) == "" {
+		return ISO8601{}, &ParseError{Input: input, Token: input, Err: ErrSyntax}
+	}
+	overflow := func(i int) error {
+		return &ParseError{Input: input, Offset: bounds[2*i], Token: group(i), Err: ErrOverflow}
+	}
+	iso := ISO8601{Negative: group(1) == "-"}
+	for i, field := range []*int{&iso.Years, &iso.Months, &iso.Weeks, &iso.Days} {
+		if group(i+2) == "" {
+			continue
+		}
+		value, err := strconv.Atoi(group(i + 2))
+		if err != nil {
+			return ISO8601{}, overflow(i + 2)
+		}
+		*field = value
+	}
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		if group(i+7) == "" {
+			continue
+		}
+		value, err := parseDecimalDuration(group(i+7), unit)
+		if err != nil || iso.Time+value < iso.Time {
+			return ISO8601{}, overflow(i + 7)
+		}
+		iso.Time += value
+	}
+	return iso, nil
+}
+
+// ISO8601Between will return the ISO 8601 duration between the dates, with months and years counted on the calendar
+// in the location of the start date, so that adding it to the start date gives the end date. Negative durations are
+// counted backwards from the start date.
+func ISO8601Between(startDate, endDate time.Time) ISO8601 {
+	iso, sign := ISO8601{}, 1
+	endDate = endDate.In(startDate.Location())
+	if endDate.Before(startDate) {
+		iso.Negative, sign = true, -1
+	}
+	// Whether the date, counted from the start date, went past the end date.
+	passed := func(date time.Time) bool {
+		return sign*date.Compare(endDate) > 0
+	}
+	months := sign * ((endDate.Year()-startDate.Year())*12 + int(endDate.Month()-startDate.Month()))
+	for months > 0 && passed(addMonths(startDate, sign*months)) {
+		months--
+	}
+	iso.Years, iso.Months = months/12, months%12
+	// Same as in calendarDurationParts, whole days are counted on the calendar.
+	anchor := addMonths(startDate, sign*months)
+	for !passed(anchor.AddDate(0, 0, sign*(iso.Days+1))) {
+		iso.Days++
+	}
+	iso.Time = time.Duration(sign) * endDate.Sub(anchor.AddDate(0, 0, sign*iso.Days))
+	return iso
+}
+
+// AddTo will return the date moved by the duration. Months and years are added on the calendar, with the day clamped
+// to the end of the resulting month, e.g. one month after January 31st is the last day of February.
+func (iso ISO8601) AddTo(date time.Time) time.Time {
+	sign := 1
+	if iso.Negative {
+		sign = -1
+	}
+	date = addMonths(date, sign*(iso.Years*12+iso.Months)).AddDate(0, 0, sign*(iso.Weeks*7+iso.Days))
+	return date.Add(time.Duration(sign) * iso.Time)
+}
+
+// Duration will return the duration, using the fixed lengths of the nominal units (Year, Month, Week and Day).
+// Durations longer than about 292 years do not fit and are saturated to the longest duration of the sign.
+func (iso ISO8601) Duration() time.Duration {
+	duration, ok := iso.duration()
+	if !ok {
+		if iso.Negative {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return duration
+}
+
+// duration will return the duration, like Duration, and whether it fits.
+func (iso ISO8601) duration() (time.Duration, bool) {
+	nominal := float64(iso.Years)*Year + float64(iso.Months)*Month + float64(iso.Weeks)*Week + float64(iso.Days)*Day
+	if math.Abs(nominal*float64(time.Second)) >= math.MaxInt64 {
+		return 0, false
+	}
+	nominalDuration := secondsToDuration(nominal)
+	duration := nominalDuration + iso.Time
+	if (iso.Time > 0 && duration < nominalDuration) || (iso.Time < 0 && duration > nominalDuration) {
+		return 0, false
+	}
+	if iso.Negative {
+		return -duration, true
+	}
+	return duration, true
+}
+
+// parseISODuration will parse the input in the ISO 8601 format into a duration, if it is one, for the duration
+// parsers. Leading and trailing spaces are ignored.
+func parseISODuration(input string) (duration time.Duration, isISO bool, err error) {
+	trimmed := strings.TrimSpace(input)
+	iso, err := ParseISO8601(trimmed)
+	if err != nil {
+		return 0, false, nil
+	}
+	duration, ok := iso.duration()
+	if !ok {
+		offset := len(input) - len(strings.TrimLeftFunc(input, unicode.IsSpace))
+		return 0, true, &ParseError{Input: input, Offset: offset, Token: trimmed, Err: ErrOverflow}
+	}
+	return duration, true, nil
+}
+
+// String will return the duration in the ISO 8601 format. Zero duration is "PT0S".
+func (iso ISO8601) String() string {
+	var formatted strings.Builder
+	if iso.Negative {
+		formatted.WriteString("-")
+	}
+	formatted.WriteString("P")
+	hasDate := false
+	for _, component := range []struct {
+		value      int
+		designator string
+	}{{iso.Years, "Y"}, {iso.Months, "M"}, {iso.Weeks, "W"}, {iso.Days, "D"}} {
+		if component.value != 0 {
+			formatted.WriteString(strconv.Itoa(component.value) + component.designator)
+			hasDate = true
+		}
+	}
+	if iso.Time == 0 && hasDate {
+		return formatted.String()
+	}
+	formatted.WriteString("T")
+	hours, minutes, seconds := iso.Time/time.Hour, iso.Time%time.Hour/time.Minute, iso.Time%time.Minute
+	if hours != 0 {
+		formatted.WriteString(strconv.FormatInt(int64(hours), 10) + "H")
+	}
+	if minutes != 0 {
+		formatted.WriteString(strconv.FormatInt(int64(minutes), 10) + "M")
+	}
+	if seconds != 0 || iso.Time == 0 {
+		formatted.WriteString(strconv.FormatInt(int64(seconds/time.Second), 10))
+		if nanos := seconds % time.Second; nanos != 0 {
+			formatted.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0"))
+		}
+		formatted.WriteString("S")
+	}
+	return formatted.String()
+}
+
+// parseDecimalDuration will parse the decimal number of units, e.g. "1.5" hours. Fraction is exact up to nanoseconds.
+func parseDecimalDuration(value string, unit time.Duration) (time.Duration, error) {
+	whole, fraction, _ := strings.Cut(strings.Replace(value, ",", ".", 1), ".")
+	count, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || count > int64(1<<63-1)/int64(unit) {
+		return 0, fmt.Errorf("value %q out of range", value)
+	}
+	// Units are whole seconds, so 9 digits of the fraction are exact.
+	fraction = (fraction + "000000000")[:9]
+	nanos, _ := strconv.ParseInt(fraction, 10, 64)
+	return time.Duration(count)*unit + time.Duration(nanos)*(unit/time.Second), nil
+}
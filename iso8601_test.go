@@ -0,0 +1,137 @@
+package humanize
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseISO8601(t *testing.T) {
+	cases := map[string]ISO8601{
+		"P1Y2M10DT2H30M": {Years: 1, Months: 2, Days: 10, Time: 2*time.Hour + 30*time.Minute},
+		"PT0.5S":         {Time: 500 * time.Millisecond},
+		"PT1,5H":         {Time: 90 * time.Minute},
+		"P3W":            {Weeks: 3},
+		"-P1D":           {Negative: true, Days: 1},
+		"pt5m":           {Time: 5 * time.Minute},
+		"PT0S":           {},
+		"PT1.000000001S": {Time: time.Second + time.Nanosecond},
+	}
+
+	for input, expected := range cases {
+		parsed, err := ParseISO8601(input)
+		if err != nil {
+			t.Errorf("Parsing %q failed with error: %s", input, err)
+		} else if parsed != expected {
+			t.Errorf("Expected '%+v' for %q, got '%+v'.", expected, input, parsed)
+		}
+	}
+
+	for _, input := range []string{"", "P", "PT", "1D", "P1.5D", "PT1H2H", "P1DT", "PT99999999999999H"} {
+		if _, err := ParseISO8601(input); err == nil {
+			t.Errorf("Expected an error for %q.", input)
+		}
+	}
+}
+
+func TestISO8601_String(t *testing.T) {
+	cases := map[string]ISO8601{
+		"P1Y2M10DT2H30M": {Years: 1, Months: 2, Days: 10, Time: 2*time.Hour + 30*time.Minute},
+		"PT0.5S":         {Time: 500 * time.Millisecond},
+		"P3W":            {Weeks: 3},
+		"-P1DT1S":        {Negative: true, Days: 1, Time: time.Second},
+		"PT0S":           {},
+		"PT26H0.000001S": {Time: 26*time.Hour + time.Microsecond},
+	}
+
+	for expected, iso := range cases {
+		if formatted := iso.String(); formatted != expected {
+			t.Errorf("Expected '%s', got '%s'.", expected, formatted)
+		}
+	}
+	if formatted := FormatISO8601(-(90*time.Minute + 5*time.Second)); formatted != "-PT1H30M5S" {
+		t.Errorf("Expected '%s', got '%s'.", "-PT1H30M5S", formatted)
+	}
+}
+
+func TestISO8601_RoundTrip(t *testing.T) {
+	start := time.Date(2016, 1, 31, 22, 15, 0, 0, time.UTC)
+	ends := []time.Time{
+		time.Date(2016, 2, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2017, 3, 31, 22, 15, 0, 500, time.UTC),
+		time.Date(2016, 1, 31, 22, 15, 30, 0, time.UTC),
+		time.Date(2019, 12, 1, 3, 0, 0, 0, time.UTC),
+		time.Date(2015, 6, 15, 8, 0, 0, 0, time.UTC),
+	}
+	for _, end := range ends {
+		iso := ISO8601Between(start, end)
+		parsed, err := ParseISO8601(iso.String())
+		if err != nil {
+			t.Errorf("Parsing %q failed with error: %s", iso, err)
+			continue
+		}
+		if parsed != iso {
+			t.Errorf("Expected '%+v', got '%+v'.", iso, parsed)
+		}
+		if !parsed.AddTo(start).Equal(end) {
+			t.Errorf("Expected '%s' for %s, got '%s'.", end, iso, parsed.AddTo(start))
+		}
+	}
+	// Negative durations are counted backwards from the start date, also at the end of the month.
+	monthEnd := time.Date(2017, 3, 31, 0, 0, 0, 0, time.UTC)
+	for end, expected := range map[time.Time]string{
+		time.Date(2017, 2, 28, 0, 0, 0, 0, time.UTC): "-P1M",
+		time.Date(2017, 2, 25, 0, 0, 0, 0, time.UTC): "-P1M3D",
+		time.Date(2016, 2, 29, 6, 0, 0, 0, time.UTC): "-P1Y30DT18H",
+	} {
+		iso := ISO8601Between(monthEnd, end)
+		if iso.String() != expected {
+			t.Errorf("Expected '%s', got '%s'.", expected, iso)
+		}
+		if !iso.AddTo(monthEnd).Equal(end) {
+			t.Errorf("Expected '%s' for %s, got '%s'.", end, iso, iso.AddTo(monthEnd))
+		}
+	}
+	if iso := ISO8601Between(start, time.Date(2017, 3, 31, 22, 15, 0, 0, time.UTC)); iso.String() != "P1Y2M" {
+		t.Errorf("Expected '%s', got '%s'.", "P1Y2M", iso)
+	}
+}
+
+func TestISO8601_Duration(t *testing.T) {
+	cases := []struct {
+		iso      ISO8601
+		expected time.Duration
+	}{
+		{ISO8601{Days: 1, Time: time.Hour}, 25 * time.Hour},
+		{ISO8601{Negative: true, Weeks: 2}, -14 * 24 * time.Hour},
+		{ISO8601{Years: 290}, time.Duration(290 * Year * time.Second)},
+		// Durations over about 292 years are saturated.
+		{ISO8601{Years: 300}, math.MaxInt64},
+		{ISO8601{Negative: true, Years: 300}, math.MinInt64},
+		{ISO8601{Years: 290, Time: 99999 * time.Hour}, math.MaxInt64},
+	}
+	for _, testCase := range cases {
+		if duration := testCase.iso.Duration(); duration != testCase.expected {
+			t.Errorf("Expected '%s' for %s, got '%s'.", testCase.expected, testCase.iso, duration)
+		}
+	}
+}
+
+func TestHumanizer_ParseDuration_ISO8601(t *testing.T) {
+	humanizer, err := New("pl")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	cases := map[string]time.Duration{
+		"PT2H30M":  150 * time.Minute,
+		" P1DT1H ": 25 * time.Hour,
+		"P1M":      time.Duration(Month * time.Second),
+		"-PT0.5S":  -500 * time.Millisecond,
+		"2 dni":    48 * time.Hour,
+	}
+	for input, expected := range cases {
+		if parsed, err := humanizer.ParseDuration(input); err != nil || parsed != expected {
+			t.Errorf("Expected '%s' for %q, got '%s' (%v).", expected, input, parsed, err)
+		}
+	}
+}
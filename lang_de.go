@@ -0,0 +1,117 @@
+package humanize
+
+// German l10n. For description see language.go.
+var langDe = Language{
+	PluralRules: map[string]string{
+		"one": "i = 1 and v = 0",
+	},
+	Times: Times{
+		Ranges: []TimeRanges{
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"one":   "%d Nanosekunde",
+				"other": "%d Nanosekunden",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"one":   "%d Mikrosekunde",
+				"other": "%d Mikrosekunden",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"one":   "%d Millisekunde",
+				"other": "%d Millisekunden",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"one":   "%d Sekunde",
+				"other": "%d Sekunden",
+			}},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"one":   "%d Minute",
+				"other": "%d Minuten",
+			}},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"one":   "%d Stunde",
+				"other": "%d Stunden",
+			}},
+			// Dative plural is needed after "in" and "vor".
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"one":   "%d Tag",
+				"other": "%d Tage",
+			}, PastForms: map[string]string{
+				"other": "%d Tagen",
+			}, FutureForms: map[string]string{
+				"other": "%d Tagen",
+			}},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"one":   "%d Woche",
+				"other": "%d Wochen",
+			}},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"one":   "%d Monat",
+				"other": "%d Monate",
+			}, PastForms: map[string]string{
+				"other": "%d Monaten",
+			}, FutureForms: map[string]string{
+				"other": "%d Monaten",
+			}},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"one":   "%d Jahr",
+				"other": "%d Jahre",
+			}, PastForms: map[string]string{
+				"other": "%d Jahren",
+			}, FutureForms: map[string]string{
+				"other": "%d Jahren",
+			}},
+		},
+		Future:       "in %s",
+		Past:         "vor %s",
+		Now:          "jetzt",
+		PartSep:      ", ",
+		RemainderSep: " und ",
+		Units: map[string]float64{
+			"Nanosekunde":  Nanosecond,
+			"Mikrosekunde": Microsecond,
+			"Millisekunde": Millisecond,
+			"ns":           Nanosecond,
+			"µs":           Microsecond,
+			"ms":           Millisecond,
+			"Sekunde":      1,
+			"Minute":       Minute,
+			"Stunde":       Hour,
+			"Tag":          Day,
+			"Woche":        Week,
+			"Monat":        Month,
+			"Jahr":         Year,
+		},
+	},
+	Prefixes: map[string]string{
+		// SI.
+		"Y":  "yotta",
+		"Z":  "zetta",
+		"E":  "exa",
+		"P":  "peta",
+		"T":  "tera",
+		"G":  "giga",
+		"M":  "mega",
+		"k":  "kilo",
+		"h":  "hekto",
+		"da": "deka",
+		"d":  "dezi",
+		"c":  "zenti",
+		"m":  "milli",
+		"µ":  "mikro",
+		"n":  "nano",
+		"p":  "piko",
+		"f":  "femto",
+		"a":  "atto",
+		"z":  "zepto",
+		"y":  "yokto",
+		// Bit.
+		"Yi": "yobi",
+		"Zi": "zebi",
+		"Ei": "exbi",
+		"Pi": "pebi",
+		"Ti": "tebi",
+		"Gi": "gibi",
+		"Mi": "mebi",
+		"Ki": "kibi",
+	},
+}
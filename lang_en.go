@@ -1,46 +1,182 @@
 package humanize
 
-// English l10n. For description see lang_structs.go.
-var langEn = languageProvider{
-	times: times{
-		ranges: []timeRanges{
-			{Minute, 1, false, 0, "1 second", []timeRange{
-				{LongTime, "%d seconds"},
+// English l10n. For description see language.go.
+
+import "time"
+
+var langEn = Language{
+	PluralRules: map[string]string{
+		"one": "i = 1 and v = 0",
+	},
+	Times: Times{
+		Ranges: []TimeRanges{
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"one":   "%d nanosecond",
+				"other": "%d nanoseconds",
+			}, ShortForms: map[string]string{
+				"other": "%d ns",
+			}, NarrowForms: map[string]string{
+				"other": "%dns",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"one":   "%d microsecond",
+				"other": "%d microseconds",
+			}, ShortForms: map[string]string{
+				"other": "%d µs",
+			}, NarrowForms: map[string]string{
+				"other": "%dµs",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"one":   "%d millisecond",
+				"other": "%d milliseconds",
+			}, ShortForms: map[string]string{
+				"other": "%d ms",
+			}, NarrowForms: map[string]string{
+				"other": "%dms",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"one":   "%d second",
+				"other": "%d seconds",
+			}, ShortForms: map[string]string{
+				"other": "%d sec",
+			}, NarrowForms: map[string]string{
+				"other": "%ds",
 			}},
-			{Hour, Minute, false, 0, "1 minute", []timeRange{
-				{LongTime, "%d minutes"},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"one":   "%d minute",
+				"other": "%d minutes",
+			}, ShortForms: map[string]string{
+				"other": "%d min",
+			}, NarrowForms: map[string]string{
+				"other": "%dm",
 			}},
-			{Day, Hour, false, 0, "1 hour", []timeRange{
-				{LongTime, "%d hours"},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"one":   "%d hour",
+				"other": "%d hours",
+			}, ShortForms: map[string]string{
+				"other": "%d hr",
+			}, NarrowForms: map[string]string{
+				"other": "%dh",
 			}},
-			{Week, Day, false, 0, "1 day", []timeRange{
-				{LongTime, "%d days"},
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"one":   "%d day",
+				"other": "%d days",
+			}, ShortForms: map[string]string{
+				"one":   "%d day",
+				"other": "%d days",
+			}, NarrowForms: map[string]string{
+				"other": "%dd",
 			}},
-			{Month, Week, true, 0, "1 week", []timeRange{
-				{LongTime, "%d weeks"},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"one":   "%d week",
+				"other": "%d weeks",
+			}, ShortForms: map[string]string{
+				"one":   "%d wk",
+				"other": "%d wks",
+			}, NarrowForms: map[string]string{
+				"other": "%dw",
 			}},
-			{Year, Month, false, 0, "1 month", []timeRange{
-				{LongTime, "%d months"},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"one":   "%d month",
+				"other": "%d months",
+			}, ShortForms: map[string]string{
+				"one":   "%d mth",
+				"other": "%d mths",
+			}, NarrowForms: map[string]string{
+				"other": "%dmo",
 			}},
-			{LongTime, Year, false, 0, "1 year", []timeRange{
-				{LongTime, "%d years"},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"one":   "%d year",
+				"other": "%d years",
+			}, ShortForms: map[string]string{
+				"one":   "%d yr",
+				"other": "%d yrs",
+			}, NarrowForms: map[string]string{
+				"other": "%dy",
 			}},
 		},
-		future:       "in %s",
-		past:         "%s ago",
-		now:          "now",
-		remainderSep: "and",
-		units: inputTimeUnits{
-			"second": 1,
-			"minute": Minute,
-			"hour":   Hour,
-			"day":    Day,
-			"week":   Week,
-			"month":  Month,
-			"year":   Year,
+		Future:       "in %s",
+		Past:         "%s ago",
+		Now:          "now",
+		PartSep:      ", ",
+		RemainderSep: " and ",
+		ShortSeparators: &Separators{
+			PartSep:      ", ",
+			RemainderSep: ", ",
+		},
+		NarrowSeparators: &Separators{
+			PartSep:      " ",
+			RemainderSep: " ",
+		},
+		About:  "about %s",
+		Over:   "over %s",
+		Almost: "almost %s",
+		Units: map[string]float64{
+			"nanosecond":  Nanosecond,
+			"microsecond": Microsecond,
+			"millisecond": Millisecond,
+			"ns":          Nanosecond,
+			"µs":          Microsecond,
+			"ms":          Millisecond,
+			"us":          Microsecond,
+			"second":      1,
+			"sec":         1,
+			"s":           1,
+			"min":         Minute,
+			"m":           Minute,
+			"hr":          Hour,
+			"h":           Hour,
+			"d":           Day,
+			"wk":          Week,
+			"w":           Week,
+			"mth":         Month,
+			"mo":          Month,
+			"yr":          Year,
+			"y":           Year,
+			"minute":      Minute,
+			"hour":        Hour,
+			"day":         Day,
+			"week":        Week,
+			"month":       Month,
+			"year":        Year,
+		},
+		Relative: &RelativeWords{
+			Today:     "today",
+			Yesterday: "yesterday",
+			Tomorrow:  "tomorrow",
+			LastWeekday: []string{"last Sunday", "last Monday", "last Tuesday", "last Wednesday", "last Thursday",
+				"last Friday", "last Saturday"},
+			NextWeekday: []string{"next Sunday", "next Monday", "next Tuesday", "next Wednesday", "next Thursday",
+				"next Friday", "next Saturday"},
+			LastWeek:     "last week",
+			NextWeek:     "next week",
+			LastMonth:    "last month",
+			NextMonth:    "next month",
+			LastYear:     "last year",
+			NextYear:     "next year",
+			FirstWeekday: time.Monday,
+		},
+		Calendar: &CalendarFormats{
+			At:        "%s at %s",
+			Months:    []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+			SameYear:  "%[2]s %[1]d",
+			OtherYear: "%[2]s %[1]d, %[3]d",
+			Hour12:    true,
+		},
+	},
+	NumberWords: &NumberWords{
+		Values: map[string]float64{
+			"zero": 0, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6, "seven": 7, "eight": 8, "nine": 9,
+			"ten": 10, "eleven": 11, "twelve": 12, "thirteen": 13, "fourteen": 14, "fifteen": 15, "sixteen": 16,
+			"seventeen": 17, "eighteen": 18, "nineteen": 19, "twenty": 20, "thirty": 30, "forty": 40, "fifty": 50,
+			"sixty": 60, "seventy": 70, "eighty": 80, "ninety": 90,
+			"half": 0.5, "quarter": 0.25, "couple": 2, "dozen": 12,
 		},
+		Multipliers: map[string]float64{"hundred": 100, "thousand": 1000},
+		Articles:    []string{"a", "an"},
+		Connectors:  []string{"and", "of"},
 	},
-	prefixes: map[string]string{
+	Prefixes: map[string]string{
 		// SI.
 		"Y":  "yotta",
 		"Z":  "zetta",
@@ -0,0 +1,104 @@
+package humanize
+
+// Spanish l10n. For description see language.go.
+var langEs = Language{
+	PluralRules: map[string]string{
+		"one": "n = 1",
+	},
+	Times: Times{
+		Ranges: []TimeRanges{
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"one":   "%d nanosegundo",
+				"other": "%d nanosegundos",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"one":   "%d microsegundo",
+				"other": "%d microsegundos",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"one":   "%d milisegundo",
+				"other": "%d milisegundos",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"one":   "%d segundo",
+				"other": "%d segundos",
+			}},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"one":   "%d minuto",
+				"other": "%d minutos",
+			}},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"one":   "%d hora",
+				"other": "%d horas",
+			}},
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"one":   "%d día",
+				"other": "%d días",
+			}},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"one":   "%d semana",
+				"other": "%d semanas",
+			}},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"one":   "%d mes",
+				"other": "%d meses",
+			}},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"one":   "%d año",
+				"other": "%d años",
+			}},
+		},
+		Future:       "dentro de %s",
+		Past:         "hace %s",
+		Now:          "ahora",
+		PartSep:      ", ",
+		RemainderSep: " y ",
+		Units: map[string]float64{
+			"nanosegundo":  Nanosecond,
+			"microsegundo": Microsecond,
+			"milisegundo":  Millisecond,
+			"ns":           Nanosecond,
+			"µs":           Microsecond,
+			"ms":           Millisecond,
+			"segundo":      1,
+			"minuto":       Minute,
+			"hora":         Hour,
+			"día":          Day,
+			"semana":       Week,
+			"mes":          Month,
+			"año":          Year,
+		},
+	},
+	Prefixes: map[string]string{
+		// SI.
+		"Y":  "yotta",
+		"Z":  "zetta",
+		"E":  "exa",
+		"P":  "peta",
+		"T":  "tera",
+		"G":  "giga",
+		"M":  "mega",
+		"k":  "kilo",
+		"h":  "hecto",
+		"da": "deca",
+		"d":  "deci",
+		"c":  "centi",
+		"m":  "mili",
+		"µ":  "micro",
+		"n":  "nano",
+		"p":  "pico",
+		"f":  "femto",
+		"a":  "atto",
+		"z":  "zepto",
+		"y":  "yocto",
+		// Bit.
+		"Yi": "yobi",
+		"Zi": "zebi",
+		"Ei": "exbi",
+		"Pi": "pebi",
+		"Ti": "tebi",
+		"Gi": "gibi",
+		"Mi": "mebi",
+		"Ki": "kibi",
+	},
+}
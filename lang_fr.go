@@ -0,0 +1,105 @@
+package humanize
+
+// French l10n. For description see language.go.
+var langFr = Language{
+	PluralRules: map[string]string{
+		"one": "i = 0,1",
+	},
+	Times: Times{
+		Ranges: []TimeRanges{
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"one":   "%d nanoseconde",
+				"other": "%d nanosecondes",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"one":   "%d microseconde",
+				"other": "%d microsecondes",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"one":   "%d milliseconde",
+				"other": "%d millisecondes",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"one":   "%d seconde",
+				"other": "%d secondes",
+			}},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"one":   "%d minute",
+				"other": "%d minutes",
+			}},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"one":   "%d heure",
+				"other": "%d heures",
+			}},
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"one":   "%d jour",
+				"other": "%d jours",
+			}},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"one":   "%d semaine",
+				"other": "%d semaines",
+			}},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"one":   "%d mois",
+				"other": "%d mois",
+			}},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"one":   "%d an",
+				"other": "%d ans",
+			}},
+		},
+		Future:       "dans %s",
+		Past:         "il y a %s",
+		Now:          "maintenant",
+		PartSep:      ", ",
+		RemainderSep: " et ",
+		Units: map[string]float64{
+			"nanoseconde":  Nanosecond,
+			"microseconde": Microsecond,
+			"milliseconde": Millisecond,
+			"ns":           Nanosecond,
+			"µs":           Microsecond,
+			"ms":           Millisecond,
+			"seconde":      1,
+			"minute":       Minute,
+			"heure":        Hour,
+			"jour":         Day,
+			"semaine":      Week,
+			"mois":         Month,
+			"an":           Year,
+			"année":        Year,
+		},
+	},
+	Prefixes: map[string]string{
+		// SI.
+		"Y":  "yotta",
+		"Z":  "zetta",
+		"E":  "exa",
+		"P":  "péta",
+		"T":  "téra",
+		"G":  "giga",
+		"M":  "méga",
+		"k":  "kilo",
+		"h":  "hecto",
+		"da": "déca",
+		"d":  "déci",
+		"c":  "centi",
+		"m":  "milli",
+		"µ":  "micro",
+		"n":  "nano",
+		"p":  "pico",
+		"f":  "femto",
+		"a":  "atto",
+		"z":  "zepto",
+		"y":  "yocto",
+		// Bit.
+		"Yi": "yobi",
+		"Zi": "zébi",
+		"Ei": "exbi",
+		"Pi": "pébi",
+		"Ti": "tébi",
+		"Gi": "gibi",
+		"Mi": "mébi",
+		"Ki": "kibi",
+	},
+}
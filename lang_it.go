@@ -0,0 +1,105 @@
+package humanize
+
+// Italian l10n. For description see language.go.
+var langIt = Language{
+	PluralRules: map[string]string{
+		"one": "i = 1 and v = 0",
+	},
+	Times: Times{
+		Ranges: []TimeRanges{
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"one":   "%d nanosecondo",
+				"other": "%d nanosecondi",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"one":   "%d microsecondo",
+				"other": "%d microsecondi",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"one":   "%d millisecondo",
+				"other": "%d millisecondi",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"one":   "%d secondo",
+				"other": "%d secondi",
+			}},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"one":   "%d minuto",
+				"other": "%d minuti",
+			}},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"one":   "%d ora",
+				"other": "%d ore",
+			}},
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"one":   "%d giorno",
+				"other": "%d giorni",
+			}},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"one":   "%d settimana",
+				"other": "%d settimane",
+			}},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"one":   "%d mese",
+				"other": "%d mesi",
+			}},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"one":   "%d anno",
+				"other": "%d anni",
+			}},
+		},
+		Future:       "tra %s",
+		Past:         "%s fa",
+		Now:          "adesso",
+		PartSep:      ", ",
+		RemainderSep: " e ",
+		Units: map[string]float64{
+			"nanosecond":  Nanosecond,
+			"microsecond": Microsecond,
+			"millisecond": Millisecond,
+			"ns":          Nanosecond,
+			"µs":          Microsecond,
+			"ms":          Millisecond,
+			"second":      1,
+			"minut":       Minute,
+			"ora":         Hour,
+			"ore":         Hour,
+			"giorn":       Day,
+			"settiman":    Week,
+			"mes":         Month,
+			"ann":         Year,
+		},
+	},
+	Prefixes: map[string]string{
+		// SI.
+		"Y":  "yotta",
+		"Z":  "zetta",
+		"E":  "exa",
+		"P":  "peta",
+		"T":  "tera",
+		"G":  "giga",
+		"M":  "mega",
+		"k":  "kilo",
+		"h":  "etto",
+		"da": "deca",
+		"d":  "deci",
+		"c":  "centi",
+		"m":  "milli",
+		"µ":  "micro",
+		"n":  "nano",
+		"p":  "pico",
+		"f":  "femto",
+		"a":  "atto",
+		"z":  "zepto",
+		"y":  "yocto",
+		// Bit.
+		"Yi": "yobi",
+		"Zi": "zebi",
+		"Ei": "exbi",
+		"Pi": "pebi",
+		"Ti": "tebi",
+		"Gi": "gibi",
+		"Mi": "mebi",
+		"Ki": "kibi",
+	},
+}
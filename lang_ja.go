@@ -0,0 +1,95 @@
+package humanize
+
+// Japanese l10n. For description see language.go.
+// There are no plural forms and no spaces between the words.
+var langJa = Language{
+	Times: Times{
+		Ranges: []TimeRanges{
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"other": "%dナノ秒",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"other": "%dマイクロ秒",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"other": "%dミリ秒",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"other": "%d秒",
+			}},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"other": "%d分",
+			}},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"other": "%d時間",
+			}},
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"other": "%d日",
+			}},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"other": "%d週間",
+			}},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"other": "%dか月",
+			}},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"other": "%d年",
+			}},
+		},
+		Future:       "%s後",
+		Past:         "%s前",
+		Now:          "今",
+		PartSep:      "、",
+		RemainderSep: "と",
+		Units: map[string]float64{
+			"ナノ秒":   Nanosecond,
+			"マイクロ秒": Microsecond,
+			"ミリ秒":   Millisecond,
+			"ns":    Nanosecond,
+			"µs":    Microsecond,
+			"ms":    Millisecond,
+			"秒":     1,
+			"分":     Minute,
+			"時間":    Hour,
+			"日":     Day,
+			"週":     Week,
+			"か月":    Month,
+			"ヶ月":    Month,
+			"カ月":    Month,
+			"ヵ月":    Month,
+			"年":     Year,
+		},
+	},
+	Prefixes: map[string]string{
+		// SI.
+		"Y":  "ヨタ",
+		"Z":  "ゼタ",
+		"E":  "エクサ",
+		"P":  "ペタ",
+		"T":  "テラ",
+		"G":  "ギガ",
+		"M":  "メガ",
+		"k":  "キロ",
+		"h":  "ヘクト",
+		"da": "デカ",
+		"d":  "デシ",
+		"c":  "センチ",
+		"m":  "ミリ",
+		"µ":  "マイクロ",
+		"n":  "ナノ",
+		"p":  "ピコ",
+		"f":  "フェムト",
+		"a":  "アト",
+		"z":  "ゼプト",
+		"y":  "ヨクト",
+		// Bit.
+		"Yi": "ヨビ",
+		"Zi": "ゼビ",
+		"Ei": "エクスビ",
+		"Pi": "ペビ",
+		"Ti": "テビ",
+		"Gi": "ギビ",
+		"Mi": "メビ",
+		"Ki": "キビ",
+	},
+}
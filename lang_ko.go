@@ -0,0 +1,93 @@
+package humanize
+
+// Korean l10n. For description see language.go.
+// There are no plural forms, parts are separated with spaces only.
+var langKo = Language{
+	Times: Times{
+		Ranges: []TimeRanges{
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"other": "%d나노초",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"other": "%d마이크로초",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"other": "%d밀리초",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"other": "%d초",
+			}},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"other": "%d분",
+			}},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"other": "%d시간",
+			}},
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"other": "%d일",
+			}},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"other": "%d주",
+			}},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"other": "%d개월",
+			}},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"other": "%d년",
+			}},
+		},
+		Future:       "%s 후",
+		Past:         "%s 전",
+		Now:          "지금",
+		PartSep:      " ",
+		RemainderSep: " ",
+		Units: map[string]float64{
+			"나노초":   Nanosecond,
+			"마이크로초": Microsecond,
+			"밀리초":   Millisecond,
+			"ns":    Nanosecond,
+			"µs":    Microsecond,
+			"ms":    Millisecond,
+			"초":     1,
+			"분":     Minute,
+			"시간":    Hour,
+			"일":     Day,
+			"주":     Week,
+			"개월":    Month,
+			"달":     Month,
+			"년":     Year,
+		},
+	},
+	Prefixes: map[string]string{
+		// SI.
+		"Y":  "요타",
+		"Z":  "제타",
+		"E":  "엑사",
+		"P":  "페타",
+		"T":  "테라",
+		"G":  "기가",
+		"M":  "메가",
+		"k":  "킬로",
+		"h":  "헥토",
+		"da": "데카",
+		"d":  "데시",
+		"c":  "센티",
+		"m":  "밀리",
+		"µ":  "마이크로",
+		"n":  "나노",
+		"p":  "피코",
+		"f":  "펨토",
+		"a":  "아토",
+		"z":  "젭토",
+		"y":  "욕토",
+		// Bit.
+		"Yi": "요비",
+		"Zi": "제비",
+		"Ei": "엑스비",
+		"Pi": "페비",
+		"Ti": "테비",
+		"Gi": "기비",
+		"Mi": "메비",
+		"Ki": "키비",
+	},
+}
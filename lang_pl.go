@@ -1,60 +1,237 @@
 package humanize
 
-// Polish l10n. For description see lang_structs.go.
-var langPl = languageProvider{
-	times: times{
-		ranges: []timeRanges{
-			{Minute, 1, false, 20, "sekundę", []timeRange{
-				{2, "%d sekund"},
-				{5, "%d sekundy"},
-				{LongTime, "%d sekund"},
+// Polish l10n. For description see language.go.
+
+import "time"
+
+var langPl = Language{
+	PluralRules: map[string]string{
+		"one":  "i = 1 and v = 0",
+		"few":  "v = 0 and i % 10 = 2..4 and i % 100 != 12..14",
+		"many": "v = 0 and i != 1 and i % 10 = 0..1 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 12..14",
+	},
+	Times: Times{
+		Ranges: []TimeRanges{
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"one":   "%d nanosekunda",
+				"few":   "%d nanosekundy",
+				"many":  "%d nanosekund",
+				"other": "%d nanosekundy",
+			}, PastForms: map[string]string{
+				"one": "nanosekundę",
+			}, FutureForms: map[string]string{
+				"one": "nanosekundę",
+			}, ShortForms: map[string]string{
+				"other": "%d ns",
+			}, NarrowForms: map[string]string{
+				"other": "%d ns",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"one":   "%d mikrosekunda",
+				"few":   "%d mikrosekundy",
+				"many":  "%d mikrosekund",
+				"other": "%d mikrosekundy",
+			}, PastForms: map[string]string{
+				"one": "mikrosekundę",
+			}, FutureForms: map[string]string{
+				"one": "mikrosekundę",
+			}, ShortForms: map[string]string{
+				"other": "%d µs",
+			}, NarrowForms: map[string]string{
+				"other": "%d µs",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"one":   "%d milisekunda",
+				"few":   "%d milisekundy",
+				"many":  "%d milisekund",
+				"other": "%d milisekundy",
+			}, PastForms: map[string]string{
+				"one": "milisekundę",
+			}, FutureForms: map[string]string{
+				"one": "milisekundę",
+			}, ShortForms: map[string]string{
+				"other": "%d ms",
+			}, NarrowForms: map[string]string{
+				"other": "%d ms",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"one":   "%d sekunda",
+				"few":   "%d sekundy",
+				"many":  "%d sekund",
+				"other": "%d sekundy",
+			}, PastForms: map[string]string{
+				"one": "sekundę",
+			}, FutureForms: map[string]string{
+				"one": "sekundę",
+			}, ShortForms: map[string]string{
+				"other": "%d sek.",
+			}, NarrowForms: map[string]string{
+				"other": "%d s",
 			}},
-			{Hour, Minute, false, 20, "minutę", []timeRange{
-				{2, "%d minut"},
-				{5, "%d minuty"},
-				{Hour, "%d minut"},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"one":   "%d minuta",
+				"few":   "%d minuty",
+				"many":  "%d minut",
+				"other": "%d minuty",
+			}, PastForms: map[string]string{
+				"one": "minutę",
+			}, FutureForms: map[string]string{
+				"one": "minutę",
+			}, ShortForms: map[string]string{
+				"other": "%d min",
+			}, NarrowForms: map[string]string{
+				"other": "%d min",
 			}},
-			{Day, Hour, false, 20, "godzinę", []timeRange{
-				{2, "%d godzin"},
-				{5, "%d godziny"},
-				{LongTime, "%d godzin"},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"one":   "%d godzina",
+				"few":   "%d godziny",
+				"many":  "%d godzin",
+				"other": "%d godziny",
+			}, PastForms: map[string]string{
+				"one": "godzinę",
+			}, FutureForms: map[string]string{
+				"one": "godzinę",
+			}, ShortForms: map[string]string{
+				"other": "%d godz.",
+			}, NarrowForms: map[string]string{
+				"other": "%d g.",
 			}},
-			{Week, Day, false, 20, "1 dzień", []timeRange{
-				{LongTime, "%d dni"},
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"one":   "%d dzień",
+				"few":   "%d dni",
+				"many":  "%d dni",
+				"other": "%d dnia",
+			}, ShortForms: map[string]string{
+				"one":   "%d dzień",
+				"other": "%d dni",
+			}, NarrowForms: map[string]string{
+				"other": "%d d.",
 			}},
-			{Month, Week, true, 20, "tydzień", []timeRange{
-				{2, "%d tygodni"},
-				{5, "%d tygodnie"},
-				{LongTime, "%d tygodni"},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"one":   "%d tydzień",
+				"few":   "%d tygodnie",
+				"many":  "%d tygodni",
+				"other": "%d tygodnia",
+			}, PastForms: map[string]string{
+				"one": "tydzień",
+			}, FutureForms: map[string]string{
+				"one": "tydzień",
+			}, ShortForms: map[string]string{
+				"one":   "%d tydz.",
+				"other": "%d tyg.",
+			}, NarrowForms: map[string]string{
+				"other": "%d tydz.",
 			}},
-			{Year, Month, false, 20, "miesiąc", []timeRange{
-				{2, "%d miesięcy"},
-				{5, "%d miesiące"},
-				{LongTime, "%d miesięcy"},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"one":   "%d miesiąc",
+				"few":   "%d miesiące",
+				"many":  "%d miesięcy",
+				"other": "%d miesiąca",
+			}, PastForms: map[string]string{
+				"one": "miesiąc",
+			}, FutureForms: map[string]string{
+				"one": "miesiąc",
+			}, ShortForms: map[string]string{
+				"other": "%d mies.",
+			}, NarrowForms: map[string]string{
+				"other": "%d mies.",
 			}},
-			{LongTime, Year, false, 20, "rok", []timeRange{
-				{2, "%d lat"},
-				{5, "%d lata"},
-				{LongTime, "%d lat"},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"one":   "%d rok",
+				"few":   "%d lata",
+				"many":  "%d lat",
+				"other": "%d roku",
+			}, PastForms: map[string]string{
+				"one": "rok",
+			}, FutureForms: map[string]string{
+				"one": "rok",
+			}, ShortForms: map[string]string{
+				"one":   "%d rok",
+				"few":   "%d lata",
+				"many":  "%d lat",
+				"other": "%d roku",
+			}, NarrowForms: map[string]string{
+				"other": "%d r.",
 			}},
 		},
-		future:       "za %s",
-		past:         "%s temu",
-		now:          "teraz",
-		remainderSep: "i",
-		units: inputTimeUnits{
-			"sekund": 1,
-			"minut":  Minute,
-			"godzin": Hour,
-			"dzie":   Day,
-			"dni":    Day,
-			"ty":     Week,
-			"miesi":  Month,
-			"rok":    Year,
-			"lat":    Year,
+		Future:       "za %s",
+		Past:         "%s temu",
+		Now:          "teraz",
+		PartSep:      ", ",
+		RemainderSep: " i ",
+		ShortSeparators: &Separators{
+			PartSep:      " ",
+			RemainderSep: " ",
+		},
+		NarrowSeparators: &Separators{
+			PartSep:      " ",
+			RemainderSep: " ",
+		},
+		About:  "mniej więcej %s",
+		Over:   "ponad %s",
+		Almost: "prawie %s",
+		Units: map[string]float64{
+			"nanosekund":  Nanosecond,
+			"mikrosekund": Microsecond,
+			"milisekund":  Millisecond,
+			"ns":          Nanosecond,
+			"µs":          Microsecond,
+			"ms":          Millisecond,
+			"sekund":      1,
+			"sek":         1,
+			"s":           1,
+			"min":         Minute,
+			"godz":        Hour,
+			"g":           Hour,
+			"d":           Day,
+			"mies":        Month,
+			"r":           Year,
+			"minut":       Minute,
+			"godzin":      Hour,
+			"dzie":        Day,
+			"dni":         Day,
+			"ty":          Week,
+			"miesi":       Month,
+			"rok":         Year,
+			"lat":         Year,
+		},
+		Relative: &RelativeWords{
+			Today:     "dzisiaj",
+			Yesterday: "wczoraj",
+			Tomorrow:  "jutro",
+			LastWeekday: []string{"w zeszłą niedzielę", "w zeszły poniedziałek", "w zeszły wtorek", "w zeszłą środę",
+				"w zeszły czwartek", "w zeszły piątek", "w zeszłą sobotę"},
+			NextWeekday: []string{"w przyszłą niedzielę", "w przyszły poniedziałek", "w przyszły wtorek",
+				"w przyszłą środę", "w przyszły czwartek", "w przyszły piątek", "w przyszłą sobotę"},
+			LastWeek:     "w zeszłym tygodniu",
+			NextWeek:     "w przyszłym tygodniu",
+			LastMonth:    "w zeszłym miesiącu",
+			NextMonth:    "w przyszłym miesiącu",
+			LastYear:     "w zeszłym roku",
+			NextYear:     "w przyszłym roku",
+			FirstWeekday: time.Monday,
+		},
+		Calendar: &CalendarFormats{
+			At:        "%s o %s",
+			Months:    []string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+			SameYear:  "%[1]d %[2]s",
+			OtherYear: "%[1]d %[2]s %[3]d",
+		},
+	},
+	NumberWords: &NumberWords{
+		Values: map[string]float64{
+			"zero": 0, "jeden": 1, "jedna": 1, "jedno": 1, "dwa": 2, "dwie": 2, "trzy": 3, "cztery": 4,
+			"pięć": 5, "sześć": 6, "siedem": 7, "osiem": 8, "dziewięć": 9, "dziesięć": 10, "jedenaście": 11,
+			"dwanaście": 12, "trzynaście": 13, "czternaście": 14, "piętnaście": 15, "szesnaście": 16,
+			"siedemnaście": 17, "osiemnaście": 18, "dziewiętnaście": 19, "dwadzieścia": 20, "trzydzieści": 30,
+			"czterdzieści": 40, "pięćdziesiąt": 50, "sześćdziesiąt": 60, "siedemdziesiąt": 70, "osiemdziesiąt": 80,
+			"dziewięćdziesiąt": 90, "sto": 100, "dwieście": 200,
+			"pół": 0.5, "półtora": 1.5, "półtorej": 1.5, "ćwierć": 0.25, "parę": 2,
 		},
+		Multipliers: map[string]float64{"tysiąc": 1000, "tysiące": 1000, "tysięcy": 1000},
+		Connectors:  []string{"i"},
 	},
-	prefixes: map[string]string{
+	Prefixes: map[string]string{
 		// SI.
 		"Y":  "jotta",
 		"Z":  "zetta",
@@ -0,0 +1,153 @@
+package humanize
+
+// Russian l10n. For description see language.go.
+var langRu = Language{
+	PluralRules: map[string]string{
+		"one":  "v = 0 and i % 10 = 1 and i % 100 != 11",
+		"few":  "v = 0 and i % 10 = 2..4 and i % 100 != 12..14",
+		"many": "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14",
+	},
+	Times: Times{
+		Ranges: []TimeRanges{
+			// Feminine units take the accusative after "через" and before "назад".
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"one":   "%d наносекунда",
+				"few":   "%d наносекунды",
+				"many":  "%d наносекунд",
+				"other": "%d наносекунды",
+			}, PastForms: map[string]string{
+				"one": "%d наносекунду",
+			}, FutureForms: map[string]string{
+				"one": "%d наносекунду",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"one":   "%d микросекунда",
+				"few":   "%d микросекунды",
+				"many":  "%d микросекунд",
+				"other": "%d микросекунды",
+			}, PastForms: map[string]string{
+				"one": "%d микросекунду",
+			}, FutureForms: map[string]string{
+				"one": "%d микросекунду",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"one":   "%d миллисекунда",
+				"few":   "%d миллисекунды",
+				"many":  "%d миллисекунд",
+				"other": "%d миллисекунды",
+			}, PastForms: map[string]string{
+				"one": "%d миллисекунду",
+			}, FutureForms: map[string]string{
+				"one": "%d миллисекунду",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"one":   "%d секунда",
+				"few":   "%d секунды",
+				"many":  "%d секунд",
+				"other": "%d секунды",
+			}, PastForms: map[string]string{
+				"one": "%d секунду",
+			}, FutureForms: map[string]string{
+				"one": "%d секунду",
+			}},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"one":   "%d минута",
+				"few":   "%d минуты",
+				"many":  "%d минут",
+				"other": "%d минуты",
+			}, PastForms: map[string]string{
+				"one": "%d минуту",
+			}, FutureForms: map[string]string{
+				"one": "%d минуту",
+			}},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"one":   "%d час",
+				"few":   "%d часа",
+				"many":  "%d часов",
+				"other": "%d часа",
+			}},
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"one":   "%d день",
+				"few":   "%d дня",
+				"many":  "%d дней",
+				"other": "%d дня",
+			}},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"one":   "%d неделя",
+				"few":   "%d недели",
+				"many":  "%d недель",
+				"other": "%d недели",
+			}, PastForms: map[string]string{
+				"one": "%d неделю",
+			}, FutureForms: map[string]string{
+				"one": "%d неделю",
+			}},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"one":   "%d месяц",
+				"few":   "%d месяца",
+				"many":  "%d месяцев",
+				"other": "%d месяца",
+			}},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"one":   "%d год",
+				"few":   "%d года",
+				"many":  "%d лет",
+				"other": "%d года",
+			}},
+		},
+		Future:       "через %s",
+		Past:         "%s назад",
+		Now:          "сейчас",
+		PartSep:      ", ",
+		RemainderSep: " и ",
+		Units: map[string]float64{
+			"наносекунд":  Nanosecond,
+			"микросекунд": Microsecond,
+			"миллисекунд": Millisecond,
+			"нс":          Nanosecond,
+			"мкс":         Microsecond,
+			"мс":          Millisecond,
+			"секунд":      1,
+			"минут":       Minute,
+			"час":         Hour,
+			"день":        Day,
+			"дн":          Day,
+			"недел":       Week,
+			"месяц":       Month,
+			"год":         Year,
+			"лет":         Year,
+		},
+	},
+	Prefixes: map[string]string{
+		// SI.
+		"Y":  "иотта",
+		"Z":  "зетта",
+		"E":  "экса",
+		"P":  "пета",
+		"T":  "тера",
+		"G":  "гига",
+		"M":  "мега",
+		"k":  "кило",
+		"h":  "гекто",
+		"da": "дека",
+		"d":  "деци",
+		"c":  "санти",
+		"m":  "милли",
+		"µ":  "микро",
+		"n":  "нано",
+		"p":  "пико",
+		"f":  "фемто",
+		"a":  "атто",
+		"z":  "зепто",
+		"y":  "иокто",
+		// Bit.
+		"Yi": "йоби",
+		"Zi": "зеби",
+		"Ei": "эксби",
+		"Pi": "пеби",
+		"Ti": "теби",
+		"Gi": "гиби",
+		"Mi": "меби",
+		"Ki": "киби",
+	},
+}
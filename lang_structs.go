@@ -2,16 +2,35 @@ package humanize
 
 // Language definition structures.
 
-// List all the existing language providers here.
+import "time"
+
+// List all the existing language providers here. More can be added with RegisterLanguage.
 var languages = map[string]languageProvider{
-	"pl": langPl,
-	"en": langEn,
+	"pl": langPl.provider(),
+	"en": langEn.provider(),
+	"de": langDe.provider(),
+	"ru": langRu.provider(),
+	"uk": langUk.provider(),
+	"fr": langFr.provider(),
+	"es": langEs.provider(),
+	"it": langIt.provider(),
+	"ja": langJa.provider(),
+	"zh": langZh.provider(),
+	"ko": langKo.provider(),
 }
 
 // languageProvider is a struct defining all the needed language elements.
 type languageProvider struct {
 	times    times
 	prefixes map[string]string
+	plural   pluralRules
+	numbers  *numberWords // Nil when not defined.
+}
+
+// Numbers written as words, normalized with normalizeWord.
+type numberWords struct {
+	values, multipliers  map[string]float64
+	articles, connectors map[string]bool
 }
 
 // Time related language elements.
@@ -24,27 +43,64 @@ type times struct {
 	past string
 	// String to humanize now.
 	now string
-	// Remainder separator
-	remainderSep string
-	// Unit values for matching the input. Partial matches are ok.
+	// Parts separators, indexed by Style.
+	partSeps [3]string
+	// Remainder separators, indexed by Style.
+	remainderSeps [3]string
+	// Qualifiers of approximate durations, indexed by durationQualifier. Empty when not defined.
+	qualifiers [4]string
+	// Unit values for matching the input, in lower case and without diacritics. Partial matches are ok.
 	units inputTimeUnits
+	// Words for dates relative to today. Nil when not defined.
+	relative *relativeWords
+	// Formats of calendar timestamps. Nil when not defined.
+	calendar *calendarFormats
+}
+
+// Formats of calendar timestamps.
+type calendarFormats struct {
+	at                  string
+	months              [12]string
+	sameYear, otherYear string
+	hour12              bool
+	dayPeriods          [2]string
+}
+
+// Words for dates relative to today. Pairs are indexed by the direction: 0 for the past, 1 for the future.
+type relativeWords struct {
+	today, yesterday, tomorrow string
+	weekdays                   [2][7]string // Indexed by time.Weekday.
+	weeks, months, years       [2]string
+	firstDay                   time.Weekday
 }
 
 // Time unit definitions for input parsing. Use partial matches.
-type inputTimeUnits map[string]int64
+type inputTimeUnits map[string]time.Duration
+
+// Grammatical context in which a duration is used. Some languages decline the units differently in each.
+type durationContext int
+
+const (
+	durationStandalone durationContext = iota // E.g. "3 days".
+	durationPast                              // E.g. "3 days" in "3 days ago".
+	durationFuture                            // E.g. "3 days" in "in 3 days".
+)
+
+// Qualifier of an approximate duration.
+type durationQualifier int
+
+const (
+	qualifierExact  durationQualifier = iota // E.g. "2 years".
+	qualifierAbout                           // E.g. "about 2 years".
+	qualifierOver                            // E.g. "over 2 years".
+	qualifierAlmost                          // E.g. "almost 2 years".
+)
 
 // Definition of time ranges to match against.
 type timeRanges struct {
-	upperLimit         int64 // Range end.
-	divideBy           int64
-	skipWhenPrecise    bool   // Skip this range in precise mode (useful for skipping "weeks")
-	onlyLastDigitAfter int64  // Consider only the last digit for the unit after this number. 0 to disable.
-	singular           string // Most languages need special treatment for singular units.
-	ranges             []timeRange
-}
-
-// Represents a single time range.
-type timeRange struct {
-	upperLimit int64 // Limit in the units of the range!
-	format     string
+	upperLimit      time.Duration // Range end.
+	divideBy        time.Duration
+	skipWhenPrecise bool // Skip this range in precise mode (useful for skipping "weeks")
+	// Unit formats for each style and duration context, indexed by the plural category.
+	forms [3][3]map[string]string
 }
@@ -0,0 +1,153 @@
+package humanize
+
+// Ukrainian l10n. For description see language.go.
+var langUk = Language{
+	PluralRules: map[string]string{
+		"one":  "v = 0 and i % 10 = 1 and i % 100 != 11",
+		"few":  "v = 0 and i % 10 = 2..4 and i % 100 != 12..14",
+		"many": "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14",
+	},
+	Times: Times{
+		Ranges: []TimeRanges{
+			// Feminine units take the accusative after "через" and before "тому".
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"one":   "%d наносекунда",
+				"few":   "%d наносекунди",
+				"many":  "%d наносекунд",
+				"other": "%d наносекунди",
+			}, PastForms: map[string]string{
+				"one": "%d наносекунду",
+			}, FutureForms: map[string]string{
+				"one": "%d наносекунду",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"one":   "%d мікросекунда",
+				"few":   "%d мікросекунди",
+				"many":  "%d мікросекунд",
+				"other": "%d мікросекунди",
+			}, PastForms: map[string]string{
+				"one": "%d мікросекунду",
+			}, FutureForms: map[string]string{
+				"one": "%d мікросекунду",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"one":   "%d мілісекунда",
+				"few":   "%d мілісекунди",
+				"many":  "%d мілісекунд",
+				"other": "%d мілісекунди",
+			}, PastForms: map[string]string{
+				"one": "%d мілісекунду",
+			}, FutureForms: map[string]string{
+				"one": "%d мілісекунду",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"one":   "%d секунда",
+				"few":   "%d секунди",
+				"many":  "%d секунд",
+				"other": "%d секунди",
+			}, PastForms: map[string]string{
+				"one": "%d секунду",
+			}, FutureForms: map[string]string{
+				"one": "%d секунду",
+			}},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"one":   "%d хвилина",
+				"few":   "%d хвилини",
+				"many":  "%d хвилин",
+				"other": "%d хвилини",
+			}, PastForms: map[string]string{
+				"one": "%d хвилину",
+			}, FutureForms: map[string]string{
+				"one": "%d хвилину",
+			}},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"one":   "%d година",
+				"few":   "%d години",
+				"many":  "%d годин",
+				"other": "%d години",
+			}, PastForms: map[string]string{
+				"one": "%d годину",
+			}, FutureForms: map[string]string{
+				"one": "%d годину",
+			}},
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"one":   "%d день",
+				"few":   "%d дні",
+				"many":  "%d днів",
+				"other": "%d дня",
+			}},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"one":   "%d тиждень",
+				"few":   "%d тижні",
+				"many":  "%d тижнів",
+				"other": "%d тижня",
+			}},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"one":   "%d місяць",
+				"few":   "%d місяці",
+				"many":  "%d місяців",
+				"other": "%d місяця",
+			}},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"one":   "%d рік",
+				"few":   "%d роки",
+				"many":  "%d років",
+				"other": "%d року",
+			}},
+		},
+		Future:       "через %s",
+		Past:         "%s тому",
+		Now:          "зараз",
+		PartSep:      ", ",
+		RemainderSep: " і ",
+		Units: map[string]float64{
+			"наносекунд":  Nanosecond,
+			"мікросекунд": Microsecond,
+			"мілісекунд":  Millisecond,
+			"нс":          Nanosecond,
+			"мкс":         Microsecond,
+			"мс":          Millisecond,
+			"секунд":      1,
+			"хвилин":      Minute,
+			"годин":       Hour,
+			"день":        Day,
+			"дн":          Day,
+			"тиж":         Week,
+			"місяц":       Month,
+			"рік":         Year,
+			"рок":         Year,
+		},
+	},
+	Prefixes: map[string]string{
+		// SI.
+		"Y":  "йота",
+		"Z":  "зета",
+		"E":  "екса",
+		"P":  "пета",
+		"T":  "тера",
+		"G":  "гіга",
+		"M":  "мега",
+		"k":  "кіло",
+		"h":  "гекто",
+		"da": "дека",
+		"d":  "деци",
+		"c":  "санти",
+		"m":  "мілі",
+		"µ":  "мікро",
+		"n":  "нано",
+		"p":  "піко",
+		"f":  "фемто",
+		"a":  "ато",
+		"z":  "зепто",
+		"y":  "йокто",
+		// Bit.
+		"Yi": "йобі",
+		"Zi": "зебі",
+		"Ei": "ексбі",
+		"Pi": "пебі",
+		"Ti": "тебі",
+		"Gi": "гібі",
+		"Mi": "мебі",
+		"Ki": "кібі",
+	},
+}
@@ -0,0 +1,99 @@
+package humanize
+
+// Chinese (simplified) l10n. For description see language.go.
+// There are no plural forms and no spaces between the words, parts are not separated at all.
+var langZh = Language{
+	Times: Times{
+		Ranges: []TimeRanges{
+			{UpperLimit: Microsecond, DivideBy: Nanosecond, Forms: map[string]string{
+				"other": "%d纳秒",
+			}},
+			{UpperLimit: Millisecond, DivideBy: Microsecond, Forms: map[string]string{
+				"other": "%d微秒",
+			}},
+			{UpperLimit: Second, DivideBy: Millisecond, Forms: map[string]string{
+				"other": "%d毫秒",
+			}},
+			{UpperLimit: Minute, DivideBy: 1, Forms: map[string]string{
+				"other": "%d秒",
+			}},
+			{UpperLimit: Hour, DivideBy: Minute, Forms: map[string]string{
+				"other": "%d分钟",
+			}},
+			{UpperLimit: Day, DivideBy: Hour, Forms: map[string]string{
+				"other": "%d小时",
+			}},
+			{UpperLimit: Week, DivideBy: Day, Forms: map[string]string{
+				"other": "%d天",
+			}},
+			{UpperLimit: Month, DivideBy: Week, SkipWhenPrecise: true, Forms: map[string]string{
+				"other": "%d周",
+			}},
+			{UpperLimit: Year, DivideBy: Month, Forms: map[string]string{
+				"other": "%d个月",
+			}},
+			{UpperLimit: LongTime, DivideBy: Year, Forms: map[string]string{
+				"other": "%d年",
+			}},
+		},
+		Future:       "%s后",
+		Past:         "%s前",
+		Now:          "现在",
+		PartSep:      "",
+		RemainderSep: "",
+		Units: map[string]float64{
+			"纳秒": Nanosecond,
+			"微秒": Microsecond,
+			"毫秒": Millisecond,
+			"ns": Nanosecond,
+			"µs": Microsecond,
+			"ms": Millisecond,
+			"秒":  1,
+			"分":  Minute,
+			"分钟": Minute,
+			"分鐘": Minute,
+			"小时": Hour,
+			"小時": Hour,
+			"天":  Day,
+			"日":  Day,
+			"周":  Week,
+			"週":  Week,
+			"星期": Week,
+			"个月": Month,
+			"個月": Month,
+			"年":  Year,
+		},
+	},
+	Prefixes: map[string]string{
+		// SI.
+		"Y":  "尧",
+		"Z":  "泽",
+		"E":  "艾",
+		"P":  "拍",
+		"T":  "太",
+		"G":  "吉",
+		"M":  "兆",
+		"k":  "千",
+		"h":  "百",
+		"da": "十",
+		"d":  "分",
+		"c":  "厘",
+		"m":  "毫",
+		"µ":  "微",
+		"n":  "纳",
+		"p":  "皮",
+		"f":  "飞",
+		"a":  "阿",
+		"z":  "仄",
+		"y":  "幺",
+		// Bit.
+		"Yi": "yobi",
+		"Zi": "zebi",
+		"Ei": "exbi",
+		"Pi": "pebi",
+		"Ti": "tebi",
+		"Gi": "gibi",
+		"Mi": "mebi",
+		"Ki": "kibi",
+	},
+}
@@ -0,0 +1,438 @@
+package humanize
+
+// Public language definition and registration.
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// Longest time (in seconds) that can be expressed as time.Duration.
+const maxDurationSeconds = float64(math.MaxInt64 / time.Second)
+
+// Guards the languages map.
+var languagesMu sync.RWMutex
+
+// Language is a public definition of a language. It mirrors the structure used internally by the package
+// and can be registered with RegisterLanguage. See lang_en.go for a complete example.
+type Language struct {
+	// Time related language elements.
+	Times Times `json:"times" yaml:"times" toml:"times"`
+	// Plural rules in CLDR syntax, indexed by the category (zero, one, two, few or many), e.g. "i = 1 and v = 0".
+	// Numbers not matching any of the rules belong to the "other" category.
+	PluralRules map[string]string `json:"pluralRules" yaml:"pluralRules" toml:"pluralRules"`
+	// Long prefix names, indexed by the short prefix. All SI and bit prefixes need to be named.
+	Prefixes map[string]string `json:"prefixes" yaml:"prefixes" toml:"prefixes"`
+	// Optional numbers written as words, for parsing the input, e.g. "two hours".
+	NumberWords *NumberWords `json:"numberWords,omitempty" yaml:"numberWords,omitempty" toml:"numberWords,omitempty"`
+}
+
+// NumberWords defines the numbers written as words. Words are matched regardless of the case and the diacritics.
+type NumberWords struct {
+	// Values of the words, e.g. "two": 2, "twenty": 20 or "half": 0.5. Consecutive values are added, e.g. in
+	// "twenty two" or "two and a half".
+	Values map[string]float64 `json:"values" yaml:"values" toml:"values"`
+	// Multipliers of the preceding values, e.g. "hundred": 100.
+	Multipliers map[string]float64 `json:"multipliers,omitempty" yaml:"multipliers,omitempty" toml:"multipliers,omitempty"`
+	// Articles meaning one, unless used with a value, e.g. "a" in "a minute", but not in "a couple of hours".
+	Articles []string `json:"articles,omitempty" yaml:"articles,omitempty" toml:"articles,omitempty"`
+	// Words allowed between the values, e.g. "and" in "two and a half" or "of" in "a couple of hours".
+	Connectors []string `json:"connectors,omitempty" yaml:"connectors,omitempty" toml:"connectors,omitempty"`
+}
+
+// Times defines the time related language elements.
+type Times struct {
+	// Time ranges to humanize time. Must be sorted by UpperLimit.
+	Ranges []TimeRanges `json:"ranges" yaml:"ranges" toml:"ranges"`
+	// String for formatting time in the future, e.g. "in %s".
+	Future string `json:"future" yaml:"future" toml:"future"`
+	// String for formatting time in the past, e.g. "%s ago".
+	Past string `json:"past" yaml:"past" toml:"past"`
+	// String to humanize now.
+	Now string `json:"now" yaml:"now" toml:"now"`
+	// Separator of the parts of a precise duration, e.g. ", ".
+	PartSep string `json:"partSep" yaml:"partSep" toml:"partSep"`
+	// Separator of the last part of a precise duration, e.g. " and ". Can be empty for languages without spaces.
+	RemainderSep string `json:"remainderSep" yaml:"remainderSep" toml:"remainderSep"`
+	// Optional separators of the short and narrow styles, e.g. " " for "3h 5m". Default to PartSep and RemainderSep.
+	ShortSeparators  *Separators `json:"shortSeparators,omitempty" yaml:"shortSeparators,omitempty" toml:"shortSeparators,omitempty"`
+	NarrowSeparators *Separators `json:"narrowSeparators,omitempty" yaml:"narrowSeparators,omitempty" toml:"narrowSeparators,omitempty"`
+	// Optional qualifiers of the approximate durations, e.g. "about %s", "over %s" and "almost %s".
+	// When missing, approximate durations are rounded without a qualifier.
+	About  string `json:"about,omitempty" yaml:"about,omitempty" toml:"about,omitempty"`
+	Over   string `json:"over,omitempty" yaml:"over,omitempty" toml:"over,omitempty"`
+	Almost string `json:"almost,omitempty" yaml:"almost,omitempty" toml:"almost,omitempty"`
+	// Unit values (in seconds) for matching the input, e.g. Millisecond. Partial matches are ok.
+	Units map[string]float64 `json:"units" yaml:"units" toml:"units"`
+	// Optional words for dates relative to today, e.g. "yesterday". Without them RelativeDate falls back to TimeDiff.
+	Relative *RelativeWords `json:"relative,omitempty" yaml:"relative,omitempty" toml:"relative,omitempty"`
+	// Optional formats of calendar timestamps. Without them CalendarTime uses a numeric format.
+	Calendar *CalendarFormats `json:"calendar,omitempty" yaml:"calendar,omitempty" toml:"calendar,omitempty"`
+}
+
+// RelativeWords defines the words for dates relative to today.
+type RelativeWords struct {
+	Today     string `json:"today" yaml:"today" toml:"today"`
+	Yesterday string `json:"yesterday" yaml:"yesterday" toml:"yesterday"`
+	Tomorrow  string `json:"tomorrow" yaml:"tomorrow" toml:"tomorrow"`
+	// Days of the last and the next week, starting with Sunday, e.g. "last Sunday" and "next Sunday".
+	LastWeekday []string `json:"lastWeekday" yaml:"lastWeekday" toml:"lastWeekday"`
+	NextWeekday []string `json:"nextWeekday" yaml:"nextWeekday" toml:"nextWeekday"`
+	LastWeek    string   `json:"lastWeek" yaml:"lastWeek" toml:"lastWeek"`
+	NextWeek    string   `json:"nextWeek" yaml:"nextWeek" toml:"nextWeek"`
+	LastMonth   string   `json:"lastMonth" yaml:"lastMonth" toml:"lastMonth"`
+	NextMonth   string   `json:"nextMonth" yaml:"nextMonth" toml:"nextMonth"`
+	LastYear    string   `json:"lastYear" yaml:"lastYear" toml:"lastYear"`
+	NextYear    string   `json:"nextYear" yaml:"nextYear" toml:"nextYear"`
+	// First day of the week, used to tell whether a date is in the last or the next week.
+	FirstWeekday time.Weekday `json:"firstWeekday" yaml:"firstWeekday" toml:"firstWeekday"`
+}
+
+// CalendarFormats defines the formats of calendar timestamps, e.g. "Today at 14:05" or "Mar 3, 2016".
+type CalendarFormats struct {
+	// Format of a relative day with the time, e.g. "%s at %s".
+	At string `json:"at" yaml:"at" toml:"at"`
+	// Abbreviated month names, starting with January.
+	Months []string `json:"months" yaml:"months" toml:"months"`
+	// Formats of a date in the same and in other year than the reference date. Arguments are the day, the month name
+	// and the year, e.g. "%[2]s %[1]d" for "Mar 3" and "%[2]s %[1]d, %[3]d" for "Mar 3, 2016".
+	SameYear  string `json:"sameYear" yaml:"sameYear" toml:"sameYear"`
+	OtherYear string `json:"otherYear" yaml:"otherYear" toml:"otherYear"`
+	// Whether the 12-hour clock is used, unless the region of the humanizer says otherwise.
+	Hour12 bool `json:"hour12" yaml:"hour12" toml:"hour12"`
+	// Optional names of the 12-hour clock periods. Default to "AM" and "PM".
+	DayPeriods []string `json:"dayPeriods,omitempty" yaml:"dayPeriods,omitempty" toml:"dayPeriods,omitempty"`
+}
+
+// Separators defines the separators of the parts of a duration in a single style.
+type Separators struct {
+	PartSep      string `json:"partSep" yaml:"partSep" toml:"partSep"`
+	RemainderSep string `json:"remainderSep" yaml:"remainderSep" toml:"remainderSep"`
+}
+
+// TimeRanges defines a range of time expressed in a single unit, e.g. minutes.
+type TimeRanges struct {
+	// Range end, in seconds.
+	UpperLimit float64 `json:"upperLimit" yaml:"upperLimit" toml:"upperLimit"`
+	// Length of the unit, in seconds, e.g. Millisecond.
+	DivideBy float64 `json:"divideBy" yaml:"divideBy" toml:"divideBy"`
+	// Skip this range in precise mode (useful for skipping "weeks").
+	SkipWhenPrecise bool `json:"skipWhenPrecise" yaml:"skipWhenPrecise" toml:"skipWhenPrecise"`
+	// Formats of the unit, indexed by the plural category. The "other" form is required, missing ones fall back to it.
+	// The %d verb is replaced with the number. It can only be omitted in the forms of the categories matching a single
+	// number in the plural rules, e.g. Polish "sekundę" for one second, but not Russian "секунду", as "one" matches
+	// 21 as well.
+	Forms map[string]string `json:"forms" yaml:"forms" toml:"forms"`
+	// Optional formats used in the past and future formats, for languages that decline the units differently there,
+	// e.g. German "3 Tage", but "vor 3 Tagen". Missing categories fall back to Forms.
+	PastForms   map[string]string `json:"pastForms,omitempty" yaml:"pastForms,omitempty" toml:"pastForms,omitempty"`
+	FutureForms map[string]string `json:"futureForms,omitempty" yaml:"futureForms,omitempty" toml:"futureForms,omitempty"`
+	// Optional formats of the short and narrow styles, e.g. "%d hr" and "%dh". Missing styles fall back to Forms.
+	ShortForms  map[string]string `json:"shortForms,omitempty" yaml:"shortForms,omitempty" toml:"shortForms,omitempty"`
+	NarrowForms map[string]string `json:"narrowForms,omitempty" yaml:"narrowForms,omitempty" toml:"narrowForms,omitempty"`
+}
+
+// RegisterLanguage validates the language definition and makes it available to New under the given name.
+// Name has to be a valid BCP 47 language tag. Registering an existing name replaces its definition.
+func RegisterLanguage(name string, lang Language) error {
+	if _, err := language.Parse(name); err != nil {
+		return fmt.Errorf("invalid language name %q: %s", name, err)
+	}
+	if err := lang.validate(); err != nil {
+		return fmt.Errorf("invalid language %q: %s", name, err)
+	}
+	languagesMu.Lock()
+	defer languagesMu.Unlock()
+	languages[name] = lang.provider()
+	return nil
+}
+
+// validate will check whether the language definition is complete and consistent.
+func (lang *Language) validate() error {
+	plural, err := compilePluralRules(lang.PluralRules)
+	if err != nil {
+		return err
+	}
+	singleNumber := plural.singleNumberCategories()
+	def := &lang.Times
+	if len(def.Ranges) == 0 {
+		return fmt.Errorf("no time ranges defined")
+	}
+	for i, unitRanges := range def.Ranges {
+		if secondsToDuration(unitRanges.DivideBy) <= 0 {
+			return fmt.Errorf("time range %d: unit length must be positive", i)
+		}
+		if unitRanges.UpperLimit > maxDurationSeconds {
+			return fmt.Errorf("time range %d: upper limit exceeds %g seconds", i, maxDurationSeconds)
+		}
+		if i > 0 && unitRanges.UpperLimit <= def.Ranges[i-1].UpperLimit {
+			return fmt.Errorf("time range %d: ranges not sorted by upper limit", i)
+		}
+		if unitRanges.Forms[pluralOther] == "" {
+			return fmt.Errorf("time range %d: missing %q form", i, pluralOther)
+		}
+		for _, forms := range []map[string]string{unitRanges.ShortForms, unitRanges.NarrowForms} {
+			if len(forms) > 0 && forms[pluralOther] == "" {
+				return fmt.Errorf("time range %d: missing %q form of a style", i, pluralOther)
+			}
+		}
+		for _, forms := range []map[string]string{unitRanges.Forms, unitRanges.PastForms, unitRanges.FutureForms,
+			unitRanges.ShortForms, unitRanges.NarrowForms} {
+			for category, form := range forms {
+				if !isPluralCategory(category) {
+					return fmt.Errorf("time range %d: invalid plural category %q", i, category)
+				}
+				// Only the categories of a single number, e.g. "one" in English, can do without the number.
+				if !singleNumber[category] && !strings.Contains(form, "%d") {
+					return fmt.Errorf("time range %d: %s format %q has no %%d verb", i, category, form)
+				}
+			}
+		}
+	}
+	if !strings.Contains(def.Future, "%s") {
+		return fmt.Errorf("future format %q has no %%s verb", def.Future)
+	}
+	if !strings.Contains(def.Past, "%s") {
+		return fmt.Errorf("past format %q has no %%s verb", def.Past)
+	}
+	for name, qualifier := range map[string]string{"about": def.About, "over": def.Over, "almost": def.Almost} {
+		if qualifier != "" && !strings.Contains(qualifier, "%s") {
+			return fmt.Errorf("%s qualifier %q has no %%s verb", name, qualifier)
+		}
+	}
+	if def.Now == "" {
+		return fmt.Errorf("missing string for now")
+	}
+	if len(def.Units) == 0 {
+		return fmt.Errorf("no input time units defined")
+	}
+	folded := make(map[string]string, len(def.Units))
+	for unit, seconds := range def.Units {
+		if unit == "" || secondsToDuration(seconds) <= 0 || seconds > maxDurationSeconds {
+			return fmt.Errorf("invalid input time unit %q", unit)
+		}
+		// Units are matched regardless of the case and diacritics, so e.g. "Día" and "dia" have to mean the same.
+		if other, exists := folded[foldUnit(unit)]; exists && def.Units[other] != seconds {
+			return fmt.Errorf("ambiguous input time units %q and %q", other, unit)
+		}
+		folded[foldUnit(unit)] = unit
+	}
+	if err := def.Relative.validate(); err != nil {
+		return err
+	}
+	if err := def.Calendar.validate(); err != nil {
+		return err
+	}
+	if err := lang.NumberWords.validate(); err != nil {
+		return err
+	}
+	for _, prefixes := range [][]prefixDef{siPrefixes, bitPrefixes} {
+		for _, prefix := range prefixes {
+			if lang.Prefixes[prefix.short] == "" {
+				return fmt.Errorf("missing name for prefix %q", prefix.short)
+			}
+		}
+	}
+	return nil
+}
+
+// validate will check whether the relative words are complete. Missing words are valid.
+func (words *RelativeWords) validate() error {
+	if words == nil {
+		return nil
+	}
+	if len(words.LastWeekday) != 7 || len(words.NextWeekday) != 7 {
+		return fmt.Errorf("relative words: 7 weekdays required")
+	}
+	all := []string{words.Today, words.Yesterday, words.Tomorrow,
+		words.LastWeek, words.NextWeek, words.LastMonth, words.NextMonth, words.LastYear, words.NextYear}
+	all = append(all, words.LastWeekday...)
+	all = append(all, words.NextWeekday...)
+	for _, word := range all {
+		if word == "" {
+			return fmt.Errorf("relative words: missing word")
+		}
+	}
+	if words.FirstWeekday < time.Sunday || words.FirstWeekday > time.Saturday {
+		return fmt.Errorf("relative words: invalid first weekday %d", words.FirstWeekday)
+	}
+	return nil
+}
+
+// validate will check whether the calendar formats are complete. Missing formats are valid.
+func (formats *CalendarFormats) validate() error {
+	if formats == nil {
+		return nil
+	}
+	if !strings.Contains(formats.At, "%s") {
+		return fmt.Errorf("calendar: at format %q has no %%s verb", formats.At)
+	}
+	if len(formats.Months) != 12 {
+		return fmt.Errorf("calendar: 12 months required")
+	}
+	for _, format := range []string{formats.SameYear, formats.OtherYear} {
+		if format == "" || strings.Contains(fmt.Sprintf(format, 1, "Jan", 2000), "%!") {
+			return fmt.Errorf("calendar: invalid date format %q", format)
+		}
+	}
+	if formats.DayPeriods != nil && len(formats.DayPeriods) != 2 {
+		return fmt.Errorf("calendar: 2 day periods required")
+	}
+	return nil
+}
+
+// validate will check whether each number word is defined once. Missing number words are valid.
+func (words *NumberWords) validate() error {
+	if words == nil {
+		return nil
+	}
+	if len(words.Values) == 0 {
+		return fmt.Errorf("number words: no values defined")
+	}
+	defined := make(map[string]bool)
+	define := func(word string) error {
+		normalized := normalizeWord(word)
+		if normalized == "" || defined[normalized] {
+			return fmt.Errorf("number words: invalid or duplicate word %q", word)
+		}
+		defined[normalized] = true
+		return nil
+	}
+	for word, value := range words.Values {
+		if err := define(word); err != nil {
+			return err
+		}
+		if value < 0 {
+			return fmt.Errorf("number words: negative value of %q", word)
+		}
+	}
+	for word, multiplier := range words.Multipliers {
+		if err := define(word); err != nil {
+			return err
+		}
+		if multiplier <= 1 {
+			return fmt.Errorf("number words: multiplier of %q must be greater than 1", word)
+		}
+	}
+	for _, word := range append(append([]string{}, words.Articles...), words.Connectors...) {
+		if err := define(word); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// provider will convert the definition into the internal language provider.
+// Definition has to be validated first.
+func (lang *Language) provider() languageProvider {
+	ranges := make([]timeRanges, len(lang.Times.Ranges))
+	for i, unitRanges := range lang.Times.Ranges {
+		ranges[i] = timeRanges{
+			upperLimit:      secondsToDuration(unitRanges.UpperLimit),
+			divideBy:        secondsToDuration(unitRanges.DivideBy),
+			skipWhenPrecise: unitRanges.SkipWhenPrecise,
+		}
+		for context, forms := range []map[string]string{unitRanges.Forms, unitRanges.PastForms, unitRanges.FutureForms} {
+			ranges[i].forms[StyleLong][context] = copyForms(forms)
+		}
+		// Short and narrow forms are not declined.
+		ranges[i].forms[StyleShort][durationStandalone] = copyForms(unitRanges.ShortForms)
+		ranges[i].forms[StyleNarrow][durationStandalone] = copyForms(unitRanges.NarrowForms)
+	}
+	units := make(inputTimeUnits, len(lang.Times.Units))
+	for unit, seconds := range lang.Times.Units {
+		units[foldUnit(unit)] = secondsToDuration(seconds)
+	}
+	prefixes := make(map[string]string, len(lang.Prefixes))
+	for short, long := range lang.Prefixes {
+		prefixes[short] = long
+	}
+	plural, _ := compilePluralRules(lang.PluralRules)
+	// Styles without own separators use the long ones.
+	shortSeparators := Separators{lang.Times.PartSep, lang.Times.RemainderSep}
+	narrowSeparators := shortSeparators
+	if lang.Times.ShortSeparators != nil {
+		shortSeparators = *lang.Times.ShortSeparators
+	}
+	if lang.Times.NarrowSeparators != nil {
+		narrowSeparators = *lang.Times.NarrowSeparators
+	}
+	var relative *relativeWords
+	if words := lang.Times.Relative; words != nil {
+		relative = &relativeWords{
+			today:     words.Today,
+			yesterday: words.Yesterday,
+			tomorrow:  words.Tomorrow,
+			weeks:     [2]string{words.LastWeek, words.NextWeek},
+			months:    [2]string{words.LastMonth, words.NextMonth},
+			years:     [2]string{words.LastYear, words.NextYear},
+			firstDay:  words.FirstWeekday,
+		}
+		copy(relative.weekdays[0][:], words.LastWeekday)
+		copy(relative.weekdays[1][:], words.NextWeekday)
+	}
+	var calendar *calendarFormats
+	if formats := lang.Times.Calendar; formats != nil {
+		calendar = &calendarFormats{
+			at:         formats.At,
+			sameYear:   formats.SameYear,
+			otherYear:  formats.OtherYear,
+			hour12:     formats.Hour12,
+			dayPeriods: [2]string{"AM", "PM"},
+		}
+		copy(calendar.months[:], formats.Months)
+		copy(calendar.dayPeriods[:], formats.DayPeriods)
+	}
+	var numbers *numberWords
+	if words := lang.NumberWords; words != nil {
+		numbers = &numberWords{
+			values:      make(map[string]float64, len(words.Values)),
+			multipliers: make(map[string]float64, len(words.Multipliers)),
+			articles:    make(map[string]bool, len(words.Articles)),
+			connectors:  make(map[string]bool, len(words.Connectors)),
+		}
+		for word, value := range words.Values {
+			numbers.values[normalizeWord(word)] = value
+		}
+		for word, multiplier := range words.Multipliers {
+			numbers.multipliers[normalizeWord(word)] = multiplier
+		}
+		for _, word := range words.Articles {
+			numbers.articles[normalizeWord(word)] = true
+		}
+		for _, word := range words.Connectors {
+			numbers.connectors[normalizeWord(word)] = true
+		}
+	}
+	return languageProvider{
+		times: times{
+			ranges:        ranges,
+			future:        lang.Times.Future,
+			past:          lang.Times.Past,
+			now:           lang.Times.Now,
+			partSeps:      [...]string{lang.Times.PartSep, shortSeparators.PartSep, narrowSeparators.PartSep},
+			remainderSeps: [...]string{lang.Times.RemainderSep, shortSeparators.RemainderSep, narrowSeparators.RemainderSep},
+			qualifiers:    [...]string{"%s", lang.Times.About, lang.Times.Over, lang.Times.Almost},
+			units:         units,
+			relative:      relative,
+			calendar:      calendar,
+		},
+		prefixes: prefixes,
+		plural:   plural,
+		numbers:  numbers,
+	}
+}
+
+// copyForms returns a copy of the forms map.
+func copyForms(forms map[string]string) map[string]string {
+	copied := make(map[string]string, len(forms))
+	for category, format := range forms {
+		copied[category] = format
+	}
+	return copied
+}
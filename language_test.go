@@ -0,0 +1,142 @@
+package humanize
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Returns a copy of English, safe to modify.
+func testLanguage() Language {
+	lang := langEn
+	lang.Times.Ranges = append([]TimeRanges(nil), langEn.Times.Ranges...)
+	lang.Prefixes = make(map[string]string)
+	for short, long := range langEn.Prefixes {
+		lang.Prefixes[short] = long
+	}
+	return lang
+}
+
+func TestLanguage_BuiltinValid(t *testing.T) {
+	for name, lang := range map[string]Language{"en": langEn, "pl": langPl, "de": langDe, "ru": langRu, "uk": langUk,
+		"fr": langFr, "es": langEs, "it": langIt,
+		"ja": langJa, "zh": langZh, "ko": langKo} {
+		if err := lang.validate(); err != nil {
+			t.Errorf("Built-in language %q is invalid: %s", name, err)
+		}
+	}
+}
+
+func TestRegisterLanguage(t *testing.T) {
+	lang := testLanguage()
+	lang.Times.Future = "za %s"
+	lang.Times.Past = "před %s"
+	lang.Times.Now = "teď"
+	lang.Prefixes["k"] = "kilo (cs)"
+
+	if err := RegisterLanguage("cs", lang); err != nil {
+		t.Fatalf("Registration failed with error: %s", err)
+	}
+	humanizer, err := New("cs")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	startDate := time.Date(2000, 6, 15, 12, 0, 0, 0, time.UTC)
+	cases := map[time.Time]string{
+		startDate:                    "teď",
+		startDate.Add(3 * time.Hour): "za 3 hours",
+		startDate.Add(-time.Minute):  "před 1 minute",
+	}
+	for endDate, expected := range cases {
+		humanized := humanizer.TimeDiff(startDate, endDate, false)
+		if humanized != expected {
+			t.Errorf("Expected '%s', got '%s'.", expected, humanized)
+		}
+	}
+	if humanized := humanizer.SiPrefix(2000, 0, 1000, false); humanized != "2 kilo (cs)" {
+		t.Errorf("Expected '2 kilo (cs)', got '%s'.", humanized)
+	}
+
+	// Changing the definition after registration has no effect.
+	lang.Times.Now = "changed"
+	if humanized := humanizer.TimeDiff(startDate, startDate, false); humanized != "teď" {
+		t.Errorf("Expected 'teď', got '%s'.", humanized)
+	}
+}
+
+func TestRegisterLanguage_Invalid(t *testing.T) {
+	cases := map[string]func(lang *Language){
+		"no time ranges": func(lang *Language) {
+			lang.Times.Ranges = nil
+		},
+		"not sorted": func(lang *Language) {
+			lang.Times.Ranges[0], lang.Times.Ranges[1] = lang.Times.Ranges[1], lang.Times.Ranges[0]
+		},
+		"unit length": func(lang *Language) {
+			lang.Times.Ranges[0].DivideBy = 0
+		},
+		"missing \"other\" form": func(lang *Language) {
+			lang.Times.Ranges[2].Forms = map[string]string{"one": "%d hour"}
+		},
+		"invalid plural category \"dual\"": func(lang *Language) {
+			lang.Times.Ranges[1].Forms = map[string]string{"dual": "%d minutes", "other": "%d minutes"}
+		},
+		"other format \"minutes\" has no %d verb": func(lang *Language) {
+			lang.Times.Ranges[1].Forms = map[string]string{"one": "minute", "other": "minutes"}
+		},
+		"few format \"Minuten\" has no %d verb": func(lang *Language) {
+			lang.Times.Ranges[1].PastForms = map[string]string{"few": "Minuten"}
+		},
+		// Russian "one" matches 21 as well, so it needs the number.
+		"one format \"минуту\" has no %d verb": func(lang *Language) {
+			lang.PluralRules = map[string]string{"one": "v = 0 and i % 10 = 1 and i % 100 != 11"}
+			lang.Times.Ranges[1].Forms = map[string]string{"one": "минуту", "other": "%d минут"}
+		},
+		"plural rule \"one\": expected number": func(lang *Language) {
+			lang.PluralRules = map[string]string{"one": "i = one"}
+		},
+		"invalid plural category \"other\"": func(lang *Language) {
+			lang.PluralRules = map[string]string{"other": "i = 1"}
+		},
+		"future": func(lang *Language) {
+			lang.Times.Future = "in"
+		},
+		"past": func(lang *Language) {
+			lang.Times.Past = ""
+		},
+		"now": func(lang *Language) {
+			lang.Times.Now = ""
+		},
+		"no input time units": func(lang *Language) {
+			lang.Times.Units = nil
+		},
+		"ambiguous input time units": func(lang *Language) {
+			lang.Times.Units = map[string]float64{"día": Day, "dia": Hour}
+		},
+		"missing name for prefix \"Ki\"": func(lang *Language) {
+			delete(lang.Prefixes, "Ki")
+		},
+	}
+
+	for expected, breakLanguage := range cases {
+		lang := testLanguage()
+		breakLanguage(&lang)
+		err := RegisterLanguage("sk", lang)
+		if err == nil {
+			t.Errorf("Registration succeeded where it should have failed (%s).", expected)
+			continue
+		}
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error containing '%s', got '%s'.", expected, err)
+		}
+	}
+	if _, err := New("sk"); err == nil {
+		t.Error("Invalid language was registered.")
+	}
+}
+
+func TestRegisterLanguage_InvalidName(t *testing.T) {
+	if err := RegisterLanguage("not a language", testLanguage()); err == nil {
+		t.Error("Registration succeeded where it should have failed.")
+	}
+}
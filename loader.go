@@ -0,0 +1,85 @@
+package humanize
+
+// Loading of language packs from files.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Supported language pack formats.
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatTOML = "toml"
+)
+
+// LoadLanguage reads a language pack in the given format (FormatJSON, FormatYAML or FormatTOML).
+// The pack has the same structure as Language, with keys in lowerCamelCase, e.g. "remainderSep".
+// Unknown keys are reported as errors, as is a definition that would be rejected by RegisterLanguage.
+func LoadLanguage(r io.Reader, format string) (Language, error) {
+	var lang Language
+	switch format {
+	case FormatJSON:
+		decoder := json.NewDecoder(r)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&lang); err != nil {
+			return Language{}, fmt.Errorf("cannot parse language pack: %s", err)
+		}
+	case FormatYAML:
+		decoder := yaml.NewDecoder(r)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&lang); err != nil {
+			return Language{}, fmt.Errorf("cannot parse language pack: %s", err)
+		}
+	case FormatTOML:
+		metadata, err := toml.NewDecoder(r).Decode(&lang)
+		if err != nil {
+			return Language{}, fmt.Errorf("cannot parse language pack: %s", err)
+		}
+		if undecoded := metadata.Undecoded(); len(undecoded) > 0 {
+			return Language{}, fmt.Errorf("cannot parse language pack: unknown key %q", undecoded[0].String())
+		}
+	default:
+		return Language{}, fmt.Errorf("unsupported language pack format: %s", format)
+	}
+	if err := lang.validate(); err != nil {
+		return Language{}, fmt.Errorf("invalid language pack: %s", err)
+	}
+	return lang, nil
+}
+
+// LoadLanguageFile reads a language pack from a file. Format is determined by the file extension
+// (.json, .yaml, .yml or .toml).
+func LoadLanguageFile(path string) (Language, error) {
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	if format == "yml" {
+		format = FormatYAML
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Language{}, err
+	}
+	lang, err := LoadLanguage(bytes.NewReader(data), format)
+	if err != nil {
+		return Language{}, fmt.Errorf("%s: %s", path, err)
+	}
+	return lang, nil
+}
+
+// RegisterLanguageFile is a convenience function loading a language pack from a file and registering it.
+func RegisterLanguageFile(name string, path string) error {
+	lang, err := LoadLanguageFile(path)
+	if err != nil {
+		return err
+	}
+	return RegisterLanguage(name, lang)
+}
@@ -0,0 +1,99 @@
+package humanize
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+func TestLoadLanguage_RoundTrip(t *testing.T) {
+	encoders := map[string]func(v interface{}) ([]byte, error){
+		FormatJSON: json.Marshal,
+		FormatYAML: yaml.Marshal,
+		FormatTOML: func(v interface{}) ([]byte, error) {
+			var buffer bytes.Buffer
+			err := toml.NewEncoder(&buffer).Encode(v)
+			return buffer.Bytes(), err
+		},
+	}
+
+	for format, encode := range encoders {
+		data, err := encode(langPl)
+		if err != nil {
+			t.Fatalf("Encoding to %s failed with error: %s", format, err)
+		}
+		lang, err := LoadLanguage(bytes.NewReader(data), format)
+		if err != nil {
+			t.Errorf("Loading %s failed with error: %s", format, err)
+			continue
+		}
+		if !reflect.DeepEqual(lang, langPl) {
+			t.Errorf("Language loaded from %s differs from the original.", format)
+		}
+	}
+}
+
+func TestLoadLanguage_Invalid(t *testing.T) {
+	valid, _ := json.Marshal(langEn)
+	unsorted := strings.Replace(string(valid), `"upperLimit":60,`, `"upperLimit":7200,`, 1)
+	noPrefix := strings.Replace(string(valid), `"Ki":"kibi"`, `"Ki":""`, 1)
+	noNumber := strings.Replace(string(valid), `"other":"%d hours"`, `"other":"hours"`, 1)
+
+	cases := map[string]struct {
+		input  string
+		format string
+	}{
+		"ranges not sorted by upper limit": {unsorted, FormatJSON},
+		"missing name for prefix \"Ki\"":   {noPrefix, FormatJSON},
+		"has no %d verb":                   {noNumber, FormatJSON},
+		"unknown field":                    {`{"times": {}, "flobbers": 1}`, FormatJSON},
+		"field flobbers not found":         {"flobbers: 1", FormatYAML},
+		"unknown key \"flobbers\"":         {"flobbers = 1", FormatTOML},
+		"cannot parse":                     {"{", FormatJSON},
+		"unsupported":                      {"", "xml"},
+	}
+
+	for expected, testCase := range cases {
+		_, err := LoadLanguage(strings.NewReader(testCase.input), testCase.format)
+		if err == nil {
+			t.Errorf("Loading succeeded where it should have failed (%s).", expected)
+			continue
+		}
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error containing '%s', got '%s'.", expected, err)
+		}
+	}
+}
+
+func TestRegisterLanguageFile(t *testing.T) {
+	lang := testLanguage()
+	lang.Times.Now = "nyní"
+	data, _ := yaml.Marshal(lang)
+	path := filepath.Join(t.TempDir(), "cs.yml")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := RegisterLanguageFile("cs", path); err != nil {
+		t.Fatalf("Registration failed with error: %s", err)
+	}
+	humanizer, err := New("cs")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	if humanized := humanizer.TimeDiff(time.Time{}, time.Time{}, false); humanized != "nyní" {
+		t.Errorf("Expected 'nyní', got '%s'.", humanized)
+	}
+
+	if err := RegisterLanguageFile("cs", filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Registration succeeded where it should have failed.")
+	}
+}
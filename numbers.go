@@ -1,11 +1,205 @@
 package humanize
 
-import "golang.org/x/text/number"
+import (
+	"golang.org/x/text/number"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Regular expression matching the words of the input.
+var wordRe = regexp.MustCompile(`\p{L}+`)
 
 // HumanizeNumber makes the number easily readable by adding decimal separators.
 // Arguments:
 //   value - the value to be formatted
 //   digits - number of (max) fraction digits to be shown
 func (humanizer *Humanizer) HumanizeNumber(value float64, digits int) string {
-	return humanizer.printer.Sprintf("%g", number.Decimal(value, number.MaxFractionDigits(digits)))
+	return humanizer.formatDecimal(value, digits)
+}
+
+// formatDecimal formats the value according to the locale, with at most the given number of fraction digits.
+// Trailing zeroes are not shown.
+func (humanizer *Humanizer) formatDecimal(value float64, digits int) string {
+	return humanizer.printer.Sprint(number.Decimal(value, number.MaxFractionDigits(digits)))
+}
+
+// formatUngrouped formats the value like formatDecimal, but without grouping the digits, e.g. "1000" in "1000µ".
+func (humanizer *Humanizer) formatUngrouped(value float64, digits int) string {
+	return humanizer.printer.Sprint(number.Decimal(value, number.MaxFractionDigits(digits), number.NoSeparator()))
+}
+
+// numberSymbols returns the symbols of the locale grouping the digits and separating the decimals, e.g. "," and "."
+// in English, and whether the digits are grouped in the Indian style, e.g. "12,34,567".
+func (humanizer *Humanizer) numberSymbols() (group, decimal string, indian bool) {
+	formatted := humanizer.formatDecimal(1234567.5, 1)
+	isDigit := func(r rune) bool { return r >= '0' && r <= '9' }
+	symbols := strings.FieldsFunc(formatted, isDigit)
+	digits := strings.FieldsFunc(formatted, func(r rune) bool { return !isDigit(r) })
+	if len(symbols) == 0 {
+		return "", ".", false
+	}
+	decimal = symbols[len(symbols)-1]
+	if len(symbols) > 1 {
+		group = symbols[0]
+	}
+	return group, decimal, len(digits) > 3 && len(digits[1]) == 2
+}
+
+// ParseNumberWords will parse a number written in words, e.g.:
+//
+//	"twenty one" -> 21
+//	"two and a half" -> 2.5
+//	"a couple" -> 2
+func (humanizer *Humanizer) ParseNumberWords(input string) (float64, error) {
+	tokens := splitWords(input)
+	if len(tokens) == 0 {
+		return 0, &ParseError{Input: input, Err: ErrEmptyInput}
+	}
+	words := humanizer.provider.numbers
+	offset := 0
+	for _, token := range tokens {
+		offset += strings.Index(input[offset:], token)
+		if words == nil || !words.isNumberWord(token) {
+			return 0, &ParseError{Input: input, Offset: offset, Token: token, Err: ErrSyntax}
+		}
+		offset += len(token)
+	}
+	value, ok := words.value(tokens)
+	if !ok {
+		start := len(input) - len(strings.TrimLeftFunc(input, unicode.IsSpace))
+		return 0, &ParseError{Input: input, Offset: start, Token: strings.TrimSpace(input), Err: ErrSyntax}
+	}
+	return value, nil
+}
+
+// splitWords splits the input into words separated by spaces or hyphens, e.g. "twenty-one".
+func splitWords(input string) []string {
+	return strings.FieldsFunc(input, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-'
+	})
+}
+
+// normalizeWord returns the word in lower case and without diacritics, for matching the input.
+func normalizeWord(word string) string {
+	return strings.ToLower(foldAccents(strings.TrimSpace(word)))
+}
+
+// isNumberWord checks whether the word is a value, multiplier, article or connector.
+func (words *numberWords) isNumberWord(word string) bool {
+	word = normalizeWord(word)
+	_, isValue := words.values[word]
+	_, isMultiplier := words.multipliers[word]
+	return isValue || isMultiplier || words.articles[word] || words.connectors[word]
+}
+
+// value returns the number of the number words. Articles count as one only when there are no values.
+func (words *numberWords) value(tokens []string) (float64, bool) {
+	total, current := 0.0, 0.0
+	values, articles := 0, 0
+	for _, token := range tokens {
+		token = normalizeWord(token)
+		if value, exists := words.values[token]; exists {
+			current += value
+			values++
+		} else if multiplier, exists := words.multipliers[token]; exists {
+			if current == 0 {
+				current = 1
+			}
+			// Large multipliers close the group, e.g. "two thousand" in "two thousand five hundred".
+			if current *= multiplier; multiplier >= 1000 {
+				total, current = total+current, 0
+			}
+			values++
+		} else if words.articles[token] {
+			articles++
+		}
+	}
+	if values == 0 {
+		return 1, articles > 0
+	}
+	return total + current, true
+}
+
+// replaceNumberWords will replace the numbers written as words in the input with digits, e.g. "two hours" with
+// "2 hours". A fraction without a unit refers to the previous unit, e.g. "an hour and a half" is replaced with
+// "1 hour and 0.5 hour".
+func (humanizer *Humanizer) replaceNumberWords(input string) string {
+	words := humanizer.provider.numbers
+	if words == nil {
+		return input
+	}
+	locations := wordRe.FindAllStringIndex(input, -1)
+	word := func(i int) string {
+		return input[locations[i][0]:locations[i][1]]
+	}
+	isUnit := func(i int) bool {
+		return i < len(locations) && humanizer.isUnitWord(word(i))
+	}
+	// Whether only spaces separate the word from the previous one.
+	adjacent := func(i int) bool {
+		return i > 0 && strings.TrimSpace(input[locations[i-1][1]:locations[i][0]]) == ""
+	}
+	// Whether the word is a unit following a number, e.g. "hours" in "2 hours" or "two hours".
+	isCountedUnit := func(i int) bool {
+		if i < 0 || !isUnit(i) {
+			return false
+		}
+		last, _ := utf8.DecodeLastRuneInString(strings.TrimSpace(input[:locations[i][0]]))
+		return unicode.IsDigit(last) || adjacent(i) && words.isNumberWord(word(i-1)) &&
+			!words.connectors[normalizeWord(word(i-1))]
+	}
+	// Whether the words are only articles, e.g. "a".
+	onlyArticles := func(i, end int) bool {
+		for ; i < end; i++ {
+			if !words.articles[normalizeWord(word(i))] {
+				return false
+			}
+		}
+		return true
+	}
+	var replaced strings.Builder
+	last, previousUnit := 0, ""
+	for i := 0; i < len(locations); {
+		// Find the number words separated only by spaces or hyphens.
+		end := i
+		for end < len(locations) && words.isNumberWord(word(end)) &&
+			(end == i || strings.Trim(input[locations[end-1][1]:locations[end][0]], " \t-") == "") {
+			end++
+		}
+		next := end
+		// Connectors are not numbers on their own, e.g. "and" in "1 hour and 5 minutes".
+		for i < end && words.connectors[normalizeWord(word(i))] {
+			i++
+		}
+		for end > i && words.connectors[normalizeWord(word(end-1))] {
+			end--
+		}
+		// Articles are numbers only right before a unit, e.g. "a day" in "a day and 2 hours", but not after a counted
+		// unit, e.g. "a day" in "2 hours a day".
+		if i < end && onlyArticles(i, end) && (!isUnit(end) || !adjacent(end) || adjacent(i) && isCountedUnit(i-1)) {
+			i = next
+			continue
+		}
+		if i == end {
+			if isUnit(i) {
+				previousUnit = word(i)
+			}
+			i = max(next, i+1)
+			continue
+		}
+		value, _ := words.value(splitWords(input[locations[i][0]:locations[end-1][1]]))
+		replacement := strconv.FormatFloat(value, 'f', -1, 64)
+		if value < 1 && !isUnit(end) && previousUnit != "" {
+			replacement += " " + previousUnit
+		}
+		replaced.WriteString(input[last:locations[i][0]])
+		replaced.WriteString(replacement)
+		// Trailing connectors are replaced too, e.g. "of" in "a couple of hours".
+		last, i = locations[next-1][1], next
+	}
+	replaced.WriteString(input[last:])
+	return replaced.String()
 }
@@ -25,3 +25,58 @@ func TestHumanizer_HumanizeNumber(t *testing.T) {
 		}
 	}
 }
+
+func TestHumanizer_ParseNumberWords(t *testing.T) {
+	cases := map[string]map[string]float64{
+		"en": {
+			"seven":                         7,
+			"Twenty-one":                    21,
+			"two and a half":                2.5,
+			"a couple":                      2,
+			"an":                            1,
+			"a quarter":                     0.25,
+			"three hundred and five":        305,
+			"two thousand five hundred ten": 2510,
+		},
+		"pl": {
+			"dwadzieścia dwa": 22,
+			"pół":             0.5,
+			"półtora":         1.5,
+			"dwa tysiące":     2000,
+			"sto i pięć":      105,
+		},
+	}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for input, expected := range caseList {
+			parsed, err := humanizer.ParseNumberWords(input)
+			if err != nil {
+				t.Errorf("%s: parsing %q failed with error: %s", lang, input, err)
+			} else if parsed != expected {
+				t.Errorf("%s: expected '%g' for %q, got '%g'.", lang, expected, input, parsed)
+			}
+		}
+	}
+}
+
+func TestHumanizer_ParseNumberWords_Errors(t *testing.T) {
+	cases := map[string][]string{
+		"en": {"", "and", "two apples", "5"},
+		"de": {"zwei"}, // No number words defined.
+	}
+	for lang, inputs := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for _, input := range inputs {
+			if _, err := humanizer.ParseNumberWords(input); err == nil {
+				t.Errorf("%s: expected an error for %q.", lang, input)
+			}
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package humanize
+
+// Parse errors and strict duration parsing functions.
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Reasons of the parse errors, wrapped in *ParseError. Check them with errors.Is, e.g.:
+//
+//	if errors.Is(err, humanize.ErrUnknownUnit) { ... }
+var (
+	ErrEmptyInput  = errors.New("empty input")
+	ErrSyntax      = errors.New("invalid syntax")
+	ErrUnknownUnit = errors.New("unknown unit")
+	ErrMissingUnit = errors.New("missing unit")
+	ErrOverflow    = errors.New("value out of range")
+)
+
+// ParseError describes the part of the input that could not be parsed. It is returned by all the parse functions.
+type ParseError struct {
+	Input  string // Whole input.
+	Offset int    // Byte offset of the offending token in the input.
+	Token  string // Offending token. Empty at the end of the input.
+	Err    error  // Reason, e.g. ErrUnknownUnit.
+}
+
+// Error returns the description of the error, e.g. `cannot parse "5 bananas": unknown unit "bananas" at offset 2`.
+func (err *ParseError) Error() string {
+	if err.Token == "" {
+		return fmt.Sprintf("cannot parse %q: %s at offset %d", err.Input, err.Err, err.Offset)
+	}
+	return fmt.Sprintf("cannot parse %q: %s %q at offset %d", err.Input, err.Err, err.Token, err.Offset)
+}
+
+// Unwrap returns the reason of the error.
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
+
+// Regular expression matching a number at the beginning of the input.
+var numberRe = regexp.MustCompile(`^[0-9]+(?:[.,][0-9]+)?`)
+
+// ParseDurationStrict will parse the duration like ParseDuration, but reject anything that is not a part of the
+// duration, e.g. "bananas" in "2 days and 5 bananas". Units without a number count as one, e.g. "minutę" in Polish
+// "minutę temu".
+func (humanizer *Humanizer) ParseDurationStrict(input string) (time.Duration, error) {
+	if strings.TrimSpace(input) == "" {
+		return 0, &ParseError{Input: input, Err: ErrEmptyInput}
+	}
+	if duration, isISO, err := parseISODuration(input); isISO {
+		return duration, err
+	}
+	start, end, sign, err := humanizer.durationBounds(input)
+	if err != nil {
+		return 0, err
+	}
+	duration, err := newDurationScanner(humanizer, input[start:end]).scan()
+	if err != nil {
+		err.Input, err.Offset = input, start+err.Offset
+		return 0, err
+	}
+	return sign * duration, nil
+}
+
+// durationBounds returns the bounds of the duration in the input, without the language's past or future template and
+// the leading sign, and the sign of the duration, e.g. -1 for "3 hours ago" or "-3 hours". Sign cannot be combined
+// with the template, e.g. "-3 hours ago" is a syntax error.
+func (humanizer *Humanizer) durationBounds(input string) (int, int, time.Duration, *ParseError) {
+	start, end, ok := templateBounds(input, humanizer.provider.times.past)
+	sign := time.Duration(-1)
+	if !ok {
+		start, end, ok = templateBounds(input, humanizer.provider.times.future)
+		sign = 1
+	}
+	if !ok {
+		start, end = 0, len(input)
+	}
+	text := input[start:end]
+	trimmed := strings.TrimLeftFunc(text, unicode.IsSpace)
+	if !strings.HasPrefix(trimmed, "-") && !strings.HasPrefix(trimmed, "+") {
+		return start, end, sign, nil
+	}
+	offset := start + len(text) - len(trimmed)
+	if ok {
+		return 0, 0, 0, &ParseError{Input: input, Offset: offset, Token: trimmed[:1], Err: ErrSyntax}
+	}
+	if trimmed[0] == '-' {
+		sign = -1
+	}
+	return offset + 1, end, sign, nil
+}
+
+// templateBounds returns the bounds of the part of the input matched by the %s verb of the template, e.g. "3 hours"
+// of "3 hours ago". Template is matched regardless of the case and the surrounding spaces.
+func templateBounds(input, template string) (int, int, bool) {
+	prefix, suffix, found := strings.Cut(template, "%s")
+	start := len(input) - len(strings.TrimLeftFunc(input, unicode.IsSpace))
+	end := len(strings.TrimRightFunc(input, unicode.IsSpace))
+	if !found || end-start < len(prefix)+len(suffix) {
+		return 0, 0, false
+	}
+	if !strings.EqualFold(input[start:start+len(prefix)], prefix) ||
+		!strings.EqualFold(input[end-len(suffix):end], suffix) {
+		return 0, 0, false
+	}
+	inner := input[start+len(prefix) : end-len(suffix)]
+	start += len(prefix) + len(inner) - len(strings.TrimLeftFunc(inner, unicode.IsSpace))
+	end = start + len(strings.TrimSpace(inner))
+	return start, end, start < end
+}
+
+// durationScanner reads the parts of a duration from the input, e.g. "2 days" and "5 hours" of "2 days and 5 hours".
+type durationScanner struct {
+	humanizer *Humanizer
+	input     string
+	folded    string   // Input without diacritics.
+	offsets   []int    // Offsets in the input of the bytes of the folded input, followed by the input length.
+	position  int      // Position in the folded input.
+	fillers   []string // Words allowed between the parts, e.g. "and".
+}
+
+// newDurationScanner creates a scanner of the input, folding its diacritics, but keeping the offsets in the input.
+func newDurationScanner(humanizer *Humanizer, input string) *durationScanner {
+	scanner := &durationScanner{humanizer: humanizer, input: input}
+	var folded strings.Builder
+	for offset, r := range input {
+		foldedRune := foldAccents(string(r))
+		for range len(foldedRune) {
+			scanner.offsets = append(scanner.offsets, offset)
+		}
+		folded.WriteString(foldedRune)
+	}
+	scanner.offsets = append(scanner.offsets, len(input))
+	scanner.folded = folded.String()
+
+	times := humanizer.provider.times
+	for _, separator := range append(times.partSeps[:], times.remainderSeps[:]...) {
+		scanner.fillers = append(scanner.fillers, strings.Fields(foldAccents(separator))...)
+	}
+	return scanner
+}
+
+// scan will read the whole input, returning the sum of the parts.
+func (scanner *durationScanner) scan() (time.Duration, *ParseError) {
+	total, lastUnit, parts := time.Duration(0), time.Duration(0), 0
+	for scanner.skipSpaces(); scanner.position < len(scanner.folded); scanner.skipSpaces() {
+		start := scanner.position
+		if duration, ok := scanner.goDuration(); ok {
+			if duration > math.MaxInt64-total {
+				return 0, scanner.errorAt(start, ErrOverflow)
+			}
+			total += duration
+			parts++
+			continue
+		}
+		count, fromWords, ok := scanner.number()
+		if !ok {
+			if scanner.filler() {
+				continue
+			}
+			// Units without a number count as one, e.g. "minutę" in "minutę temu".
+			unit, ok := scanner.unit()
+			if !ok {
+				return 0, scanner.errorAt(start, ErrSyntax)
+			}
+			if unit > math.MaxInt64-total {
+				return 0, scanner.errorAt(start, ErrOverflow)
+			}
+			total, lastUnit = total+unit, unit
+			parts++
+			continue
+		}
+		scanner.skipSpaces()
+		unit, ok := scanner.unit()
+		if !ok {
+			// A fraction without a unit refers to the previous unit, e.g. "a half" in "an hour and a half".
+			if !fromWords || count >= 1 || lastUnit == 0 {
+				if scanner.position == len(scanner.folded) {
+					return 0, scanner.errorAt(scanner.position, ErrMissingUnit)
+				}
+				return 0, scanner.errorAt(scanner.position, ErrUnknownUnit)
+			}
+			unit = lastUnit
+		}
+		if count*float64(unit) >= float64(math.MaxInt64-total) {
+			return 0, scanner.errorAt(start, ErrOverflow)
+		}
+		total += time.Duration(count * float64(unit))
+		lastUnit = unit
+		parts++
+	}
+	if parts == 0 {
+		return 0, scanner.errorAt(0, ErrSyntax)
+	}
+	return total, nil
+}
+
+// goDuration reads a word in the Go syntax, e.g. "1h30m". Words cannot be signed, e.g. "-30m" in "1h -30m", as only
+// the whole duration has a sign, see durationBounds.
+func (scanner *durationScanner) goDuration() (time.Duration, bool) {
+	rest := scanner.folded[scanner.position:]
+	word := strings.TrimRight(rest[:len(rest)-len(strings.TrimLeftFunc(rest, func(r rune) bool {
+		return !unicode.IsSpace(r)
+	}))], ",;")
+	if strings.HasPrefix(word, "-") || strings.HasPrefix(word, "+") {
+		return 0, false
+	}
+	duration, err := time.ParseDuration(word)
+	// Go also accepts a bare zero, which is not a duration here.
+	if err != nil || !strings.ContainsFunc(word, unicode.IsLetter) {
+		return 0, false
+	}
+	scanner.position += len(word)
+	return duration, true
+}
+
+// number reads a number written with digits or words, e.g. "2.5" or "two and a half".
+func (scanner *durationScanner) number() (count float64, fromWords bool, ok bool) {
+	if digits := numberRe.FindString(scanner.folded[scanner.position:]); digits != "" {
+		count, _ = strconv.ParseFloat(strings.Replace(digits, ",", ".", 1), 64)
+		scanner.position += len(digits)
+		return count, false, true
+	}
+	words := scanner.humanizer.provider.numbers
+	if words == nil {
+		return 0, false, false
+	}
+	// Number words are separated by spaces or hyphens.
+	var tokens []string
+	end := scanner.position
+	for position := end; ; {
+		word := scanner.wordAt(position)
+		if word == "" || !words.isNumberWord(word) {
+			break
+		}
+		tokens = append(tokens, word)
+		end = position + len(word)
+		position = end + len(scanner.folded[end:]) - len(strings.TrimLeftFunc(scanner.folded[end:], func(r rune) bool {
+			return unicode.IsSpace(r) || r == '-'
+		}))
+	}
+	count, ok = words.value(tokens)
+	if !ok {
+		return 0, false, false
+	}
+	scanner.position = end
+	return count, true, true
+}
+
+// unit reads a unit, e.g. "hours". Longer units can be declined, e.g. "hour" in "hours", and abbreviations can end with
+// a dot, e.g. "min.".
+func (scanner *durationScanner) unit() (time.Duration, bool) {
+	rest := scanner.folded[scanner.position:]
+	for _, unit := range scanner.humanizer.timeUnits {
+		if len(rest) < len(unit) || !strings.EqualFold(rest[:len(unit)], unit) {
+			continue
+		}
+		if next, _ := utf8.DecodeRuneInString(rest[len(unit):]); !unitEnds(unit, next) {
+			continue
+		}
+		scanner.position += len(unit)
+		if last, _ := utf8.DecodeLastRuneInString(unit); utf8.RuneCountInString(unit) > 1 && isSpacedLetter(last) {
+			for next, size := scanner.nextRune(); isSpacedLetter(next); next, size = scanner.nextRune() {
+				scanner.position += size
+			}
+		}
+		scanner.consume(".")
+		return scanner.humanizer.provider.times.units[unit], true
+	}
+	return 0, false
+}
+
+// filler reads a word allowed between the parts, e.g. "and" or ",".
+func (scanner *durationScanner) filler() bool {
+	for _, filler := range scanner.fillers {
+		start := scanner.position
+		if !scanner.consume(filler) {
+			continue
+		}
+		// Words have to end, e.g. "and" is not a filler in "andromeda".
+		last, _ := utf8.DecodeLastRuneInString(filler)
+		if next, _ := scanner.nextRune(); isSpacedLetter(last) && isSpacedLetter(next) {
+			scanner.position = start
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// consume reads the text, if the input continues with it, regardless of the case.
+func (scanner *durationScanner) consume(text string) bool {
+	rest := scanner.folded[scanner.position:]
+	if len(rest) < len(text) || !strings.EqualFold(rest[:len(text)], text) {
+		return false
+	}
+	scanner.position += len(text)
+	return true
+}
+
+// skipSpaces moves the position past the spaces.
+func (scanner *durationScanner) skipSpaces() {
+	for next, size := scanner.nextRune(); unicode.IsSpace(next); next, size = scanner.nextRune() {
+		scanner.position += size
+	}
+}
+
+// nextRune returns the rune at the position and its size. At the end of the input the size is zero.
+func (scanner *durationScanner) nextRune() (rune, int) {
+	if scanner.position >= len(scanner.folded) {
+		return utf8.RuneError, 0
+	}
+	return utf8.DecodeRuneInString(scanner.folded[scanner.position:])
+}
+
+// wordAt returns the letters starting at the position.
+func (scanner *durationScanner) wordAt(position int) string {
+	rest := scanner.folded[position:]
+	return rest[:len(rest)-len(strings.TrimLeftFunc(rest, unicode.IsLetter))]
+}
+
+// errorAt returns the parse error of the token at the position: letters and digits, or a single rune.
+func (scanner *durationScanner) errorAt(position int, reason error) *ParseError {
+	rest := scanner.folded[position:]
+	end := position
+	if first, size := utf8.DecodeRuneInString(rest); size > 0 {
+		end = position + size
+		if unicode.IsLetter(first) || unicode.IsDigit(first) {
+			end = position + len(rest) - len(strings.TrimLeftFunc(rest, func(r rune) bool {
+				return unicode.IsLetter(r) || unicode.IsDigit(r)
+			}))
+		}
+	}
+	start, stop := scanner.offsets[position], scanner.offsets[end]
+	return &ParseError{Offset: start, Token: scanner.input[start:stop], Err: reason}
+}
+
+// unitEnds checks whether the unit can be followed by the rune. Units being a single letter, e.g. "h", have to end
+// the word, so that e.g. "half" is not read as "h".
+func unitEnds(unit string, next rune) bool {
+	first, size := utf8.DecodeRuneInString(unit)
+	return size < len(unit) || !isSpacedLetter(first) || !isSpacedLetter(next)
+}
+
+// isSpacedLetter checks whether the rune is a letter of a script separating the words with spaces.
+func isSpacedLetter(r rune) bool {
+	return unicode.IsLetter(r) && unicode.In(r, unicode.Latin, unicode.Cyrillic, unicode.Greek)
+}
@@ -0,0 +1,228 @@
+package humanize
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHumanizer_ParseDurationStrict(t *testing.T) {
+	cases := map[string]map[string]time.Duration{
+		"en": {
+			"2 days, 5 hours and 40 seconds": 53*time.Hour + 40*time.Second,
+			"3 hours ago":                    -3 * time.Hour,
+			"in 2 days":                      48 * time.Hour,
+			"an hour and a half":             90 * time.Minute,
+			"1h30m":                          90 * time.Minute,
+			"2 days 3h":                      51 * time.Hour,
+			"5 hrs, 10 min.":                 5*time.Hour + 10*time.Minute,
+			"-2 weeks":                       -14 * 24 * time.Hour,
+			"PT1H":                           time.Hour,
+			"3 Hours Ago":                    -3 * time.Hour,
+		},
+		"pl": {
+			"2 dni i 5 godzin":        53 * time.Hour,
+			"3 godziny temu":          -3 * time.Hour,
+			"za pół godziny":          30 * time.Minute,
+			"1 tydz. 2 godz.":         170 * time.Hour,
+			"półtorej minuty":         90 * time.Second,
+			"5 mikrosekund":           5 * time.Microsecond,
+			"minutę i 30 sekund temu": -90 * time.Second,
+		},
+		"ja": {
+			"3日、5分と1秒後": 3*24*time.Hour + 5*time.Minute + time.Second,
+			"2時間前":      -2 * time.Hour,
+		},
+		"de": {
+			"vor 2 Tagen und 5 Stunden": -53 * time.Hour,
+			"in 3 stunden":              3 * time.Hour,
+		},
+	}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for input, expected := range caseList {
+			parsed, err := humanizer.ParseDurationStrict(input)
+			if err != nil {
+				t.Errorf("%s: parsing %q failed with error: %s", lang, input, err)
+			} else if parsed != expected {
+				t.Errorf("%s: expected '%s' for %q, got '%s'.", lang, expected, input, parsed)
+			}
+		}
+	}
+}
+
+func TestHumanizer_ParseDurationStrict_Errors(t *testing.T) {
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	cases := map[string]ParseError{
+		"2 days and 5 bananas": {Offset: 13, Token: "bananas"},
+		"I slept 5 hours":      {Offset: 0, Token: "I"},
+		"5 hats":               {Offset: 2, Token: "hats"},
+		"3 many":               {Offset: 2, Token: "many"},
+		"3 hours!":             {Offset: 7, Token: "!"},
+		"1h-30m":               {Offset: 2, Token: "-"},
+		"-1h 2h +30m":          {Offset: 7, Token: "+"},
+		"in 5":                 {Offset: 4, Token: ""},
+		"and":                  {Offset: 0, Token: "and"},
+		"  ":                   {Offset: 0, Token: ""},
+	}
+
+	for input, expected := range cases {
+		_, err := humanizer.ParseDurationStrict(input)
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Expected a parse error for %q, got '%v'.", input, err)
+			continue
+		}
+		if parseErr.Input != input || parseErr.Offset != expected.Offset || parseErr.Token != expected.Token {
+			t.Errorf("Expected offset %d and token '%s' for %q, got %d and '%s'.", expected.Offset, expected.Token,
+				input, parseErr.Offset, parseErr.Token)
+		}
+	}
+}
+
+func TestHumanizer_ParseDurationStrict_Offsets(t *testing.T) {
+	// Offsets are in bytes of the original input, regardless of the diacritics.
+	humanizer, err := New("pl")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	_, err = humanizer.ParseDurationStrict("pół godziny i żółw")
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Offset != 16 || parseErr.Token != "żółw" {
+		t.Errorf("Expected a parse error of 'żółw' at offset 16, got '%v'.", err)
+	}
+}
+
+func TestHumanizer_ParseDurationStrict_RoundTrip(t *testing.T) {
+	// Humanized durations and date differences have to be parsed back strictly.
+	durations := []time.Duration{
+		time.Second,
+		-90 * time.Second,
+		2*time.Hour + 5*time.Minute,
+		22*Day*time.Second + 21*time.Hour + 350*time.Millisecond,
+		400*Day*time.Second + 5*time.Microsecond + 11*time.Nanosecond,
+	}
+	reference := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+	for lang := range languages {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for _, duration := range durations {
+			humanized := []string{humanizer.TimeDiffWith(reference, reference.Add(duration), DurationOptions{Precise: true})}
+			for _, style := range []Style{StyleLong, StyleShort, StyleNarrow} {
+				humanized = append(humanized, humanizer.HumanizeDuration(duration, DurationOptions{Precise: true, Style: style}))
+			}
+			for _, text := range humanized {
+				parsed, err := humanizer.ParseDurationStrict(text)
+				if err != nil {
+					t.Errorf("%s: parsing '%s' failed: %s", lang, text, err)
+				} else if parsed != duration {
+					t.Errorf("%s: expected '%s' for '%s', got '%s'.", lang, duration, text, parsed)
+				}
+			}
+		}
+	}
+}
+
+func TestHumanizer_ParseDuration_Sign(t *testing.T) {
+	cases := map[string]map[string]time.Duration{
+		"en": {"3 hours ago": -3 * time.Hour, "in 3 hours": 3 * time.Hour, "-3 hours": -3 * time.Hour},
+		"pl": {"3 godziny temu": -3 * time.Hour, "za 3 godziny": 3 * time.Hour},
+		"ru": {"3 часа назад": -3 * time.Hour, "через 3 часа": 3 * time.Hour},
+	}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for input, expected := range caseList {
+			if parsed, err := humanizer.ParseDuration(input); err != nil || parsed != expected {
+				t.Errorf("%s: expected '%s' for %q, got '%s' (%v).", lang, expected, input, parsed, err)
+			}
+		}
+	}
+}
+
+func TestParseError_AllParsers(t *testing.T) {
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	reference := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+	cases := []struct {
+		parse    func(input string) error
+		input    string
+		expected error
+		offset   int
+		token    string
+	}{
+		{func(input string) error { _, err := humanizer.ParseDuration(input); return err }, " ", ErrEmptyInput, 0, ""},
+		{func(input string) error { _, err := humanizer.ParseDuration(input); return err }, "soon", ErrSyntax, 0, "soon"},
+		// Lax and strict parsers report the same problem alike.
+		{func(input string) error { _, err := humanizer.ParseDuration(input); return err },
+			"5 bananas", ErrUnknownUnit, 2, "bananas"},
+		{func(input string) error { _, err := humanizer.ParseDurationStrict(input); return err },
+			"5 bananas", ErrUnknownUnit, 2, "bananas"},
+		{func(input string) error { _, err := humanizer.ParseDuration(input); return err },
+			"9999999999 years", ErrOverflow, 0, "9999999999 years"},
+		{func(input string) error { _, err := humanizer.ParseDuration(input); return err }, " P300Y", ErrOverflow, 1, "P300Y"},
+		{func(input string) error { _, err := humanizer.ParseDuration(input); return err },
+			"2562047h 2562047h", ErrOverflow, 9, "2562047h"},
+		{func(input string) error { _, err := humanizer.ParseDuration(input); return err },
+			"in 2562047h 2562047h", ErrOverflow, 12, "2562047h"},
+		{func(input string) error { _, err := humanizer.ParseDurationStrict(input); return err },
+			"2562047h 2562047h", ErrOverflow, 9, "2562047h"},
+		{func(input string) error { _, err := humanizer.ParseDuration(input); return err }, "1e3h", ErrUnknownUnit, 1, "e3h"},
+		{func(input string) error { _, err := humanizer.ParseDuration(input); return err }, "-2 hours ago", ErrSyntax, 0, "-"},
+		{func(input string) error { _, err := humanizer.ParseDurationStrict(input); return err }, "-2 hours ago", ErrSyntax, 0, "-"},
+		{func(input string) error { _, err := humanizer.ParseDurationStrict(input); return err }, "in +2 hours", ErrSyntax, 3, "+"},
+		{func(input string) error { _, err := humanizer.ParseDurationStrict(input); return err },
+			"5 apples", ErrUnknownUnit, 2, "apples"},
+		{func(input string) error { _, err := humanizer.ParseDurationStrict(input); return err },
+			"5 hours and 2", ErrMissingUnit, 13, ""},
+		{func(input string) error { _, err := humanizer.ParseDurationStrict(input); return err },
+			"9999999999 years", ErrOverflow, 0, "9999999999"},
+		{func(input string) error { _, err := humanizer.ParseDurationStrict(input); return err },
+			"-P290YT99999H", ErrOverflow, 0, "-P290YT99999H"},
+		{func(input string) error { _, err := ParseISO8601(input); return err }, "P1X", ErrSyntax, 0, "P1X"},
+		{func(input string) error { _, err := ParseISO8601(input); return err },
+			"P1DT99999999999H", ErrOverflow, 4, "99999999999"},
+		{func(input string) error { _, err := humanizer.ParseRelative(reference, input); return err },
+			"in 5 apples", ErrUnknownUnit, 5, "apples"},
+		{func(input string) error { _, err := humanizer.ParseRelative(reference, input); return err },
+			"some day", ErrSyntax, 0, "some day"},
+		{func(input string) error { _, err := humanizer.ParseRelative(reference, input); return err }, "", ErrEmptyInput, 0, ""},
+		{func(input string) error { _, err := humanizer.ParseNumberWords(input); return err },
+			"two apples", ErrSyntax, 4, "apples"},
+		{func(input string) error { _, err := humanizer.ParseNumberWords(input); return err }, "", ErrEmptyInput, 0, ""},
+		{func(input string) error { _, err := humanizer.ParseNumberWords(input); return err }, "  and", ErrSyntax, 2, "and"},
+		{func(input string) error { _, err := humanizer.ParsePrefix(input); return err }, "5 apples", ErrUnknownUnit, 2, "apples"},
+		{func(input string) error { _, err := humanizer.ParsePrefix(input); return err }, "kilo", ErrSyntax, 0, "kilo"},
+		{func(input string) error { _, err := humanizer.ParsePrefix(input); return err }, "  ", ErrEmptyInput, 0, ""},
+	}
+
+	for _, testCase := range cases {
+		err := testCase.parse(testCase.input)
+		if !errors.Is(err, testCase.expected) {
+			t.Errorf("Expected '%v' for %q, got '%v'.", testCase.expected, testCase.input, err)
+			continue
+		}
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Expected a parse error for %q, got '%v'.", testCase.input, err)
+		} else if parseErr.Input != testCase.input || parseErr.Offset != testCase.offset ||
+			parseErr.Token != testCase.token {
+			t.Errorf("Expected offset %d and token '%s' for %q, got %d and '%s'.", testCase.offset, testCase.token,
+				testCase.input, parseErr.Offset, parseErr.Token)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package humanize
+
+// Plural rules engine, evaluating CLDR plural rule expressions.
+// See https://unicode.org/reports/tr35/tr35-numbers.html#Language_Plural_Rules for the syntax.
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Plural categories in the order of evaluation. If no rule matches, the category is "other".
+var pluralCategories = []string{"zero", "one", "two", "few", "many"}
+
+const pluralOther = "other"
+
+// Operands of a number, as defined by CLDR.
+type pluralOperands struct {
+	n float64 // Absolute value.
+	i int64   // Integer digits.
+	v int64   // Number of visible fraction digits, with trailing zeroes.
+	w int64   // Number of visible fraction digits, without trailing zeroes.
+	f int64   // Visible fraction digits, with trailing zeroes.
+	t int64   // Visible fraction digits, without trailing zeroes.
+}
+
+// intPluralOperands returns the operands of an integer.
+func intPluralOperands(number int64) pluralOperands {
+	if number < 0 {
+		number = -number
+	}
+	return pluralOperands{n: float64(number), i: number}
+}
+
+// Returns the value of a single operand.
+func (operands pluralOperands) get(operand string) float64 {
+	switch operand {
+	case "n":
+		return operands.n
+	case "i":
+		return float64(operands.i)
+	case "v":
+		return float64(operands.v)
+	case "w":
+		return float64(operands.w)
+	case "f":
+		return float64(operands.f)
+	case "t":
+		return float64(operands.t)
+	}
+	return 0 // Exponent operands (c, e), the package never uses compact notation.
+}
+
+// Single range (or value) of a relation.
+type pluralRange struct {
+	from, to float64
+}
+
+// Single relation, e.g. "i % 10 = 2..4".
+type pluralRelation struct {
+	operand string
+	modulus float64 // 0 when not used.
+	negated bool
+	within  bool // Whether non integers within the ranges also match.
+	ranges  []pluralRange
+}
+
+// matches will check whether the operands satisfy the relation.
+func (relation *pluralRelation) matches(operands pluralOperands) bool {
+	value := operands.get(relation.operand)
+	if relation.modulus != 0 {
+		value = math.Mod(value, relation.modulus)
+	}
+	found := false
+	for _, valueRange := range relation.ranges {
+		if value >= valueRange.from && value <= valueRange.to && (relation.within || value == math.Trunc(value)) {
+			found = true
+			break
+		}
+	}
+	return found != relation.negated
+}
+
+// Condition is a list of alternatives, each being a list of relations that all have to match.
+type pluralCondition [][]pluralRelation
+
+// Single compiled rule.
+type pluralRule struct {
+	category  string
+	condition pluralCondition
+}
+
+// Compiled plural rules of a language, sorted in the order of evaluation.
+type pluralRules []pluralRule
+
+// compilePluralRules will compile rule expressions, indexed by the plural category.
+func compilePluralRules(rules map[string]string) (pluralRules, error) {
+	for category := range rules {
+		if !isPluralCategory(category) || category == pluralOther {
+			return nil, fmt.Errorf("invalid plural category %q", category)
+		}
+	}
+	compiled := make(pluralRules, 0, len(rules))
+	for _, category := range pluralCategories {
+		rule, exists := rules[category]
+		if !exists {
+			continue
+		}
+		condition, err := parsePluralCondition(rule)
+		if err != nil {
+			return nil, fmt.Errorf("plural rule %q: %s", category, err)
+		}
+		compiled = append(compiled, pluralRule{category, condition})
+	}
+	return compiled, nil
+}
+
+// category returns the plural category for the operands.
+func (rules pluralRules) category(operands pluralOperands) string {
+	for _, rule := range rules {
+		for _, relations := range rule.condition {
+			matched := true
+			for i := range relations {
+				if !relations[i].matches(operands) {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				return rule.category
+			}
+		}
+	}
+	return pluralOther
+}
+
+// Largest integer checked by singleNumberCategories.
+const singleNumberLimit = 1000
+
+// singleNumberCategories returns the categories matching exactly one integer, e.g. "one" in English, but not in
+// Russian, where it matches 1, 21, 31 and so on. Integers up to singleNumberLimit are checked.
+func (rules pluralRules) singleNumberCategories() map[string]bool {
+	matches := make(map[string]int)
+	for number := int64(0); number <= singleNumberLimit; number++ {
+		matches[rules.category(intPluralOperands(number))]++
+	}
+	single := make(map[string]bool)
+	for category, count := range matches {
+		if count == 1 {
+			single[category] = true
+		}
+	}
+	return single
+}
+
+// formatCount will format the count using the form matching its plural category.
+// Forms are searched in the given order, first for the category, then for "other".
+func (humanizer *Humanizer) formatCount(count int64, forms ...map[string]string) string {
+	category := humanizer.provider.plural.category(intPluralOperands(count))
+	form := ""
+	for _, searched := range []string{category, pluralOther} {
+		for _, candidates := range forms {
+			if form = candidates[searched]; form != "" {
+				break
+			}
+		}
+		if form != "" {
+			break
+		}
+	}
+	if !strings.Contains(form, "%") { // Number is implied by the form.
+		return form
+	}
+	return fmt.Sprintf(form, count)
+}
+
+// isPluralCategory checks whether the name is a valid CLDR plural category.
+func isPluralCategory(name string) bool {
+	if name == pluralOther {
+		return true
+	}
+	for _, category := range pluralCategories {
+		if category == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Parser of the rule expressions.
+type pluralParser struct {
+	tokens []string
+	pos    int
+}
+
+// parsePluralCondition will parse a single rule expression, e.g. "v = 0 and i % 10 = 1".
+// Samples (starting with "@") are ignored.
+func parsePluralCondition(rule string) (pluralCondition, error) {
+	if sample := strings.IndexRune(rule, '@'); sample >= 0 {
+		rule = rule[:sample]
+	}
+	parser := &pluralParser{tokens: tokenizePluralRule(rule)}
+	if len(parser.tokens) == 0 {
+		return nil, fmt.Errorf("empty rule")
+	}
+	var condition pluralCondition
+	for {
+		var relations []pluralRelation
+		for {
+			relation, err := parser.relation()
+			if err != nil {
+				return nil, err
+			}
+			relations = append(relations, relation)
+			if !parser.accept("and") {
+				break
+			}
+		}
+		condition = append(condition, relations)
+		if !parser.accept("or") {
+			break
+		}
+	}
+	if parser.pos < len(parser.tokens) {
+		return nil, fmt.Errorf("unexpected %q", parser.tokens[parser.pos])
+	}
+	return condition, nil
+}
+
+// Splits the rule into words, numbers and operators.
+func tokenizePluralRule(rule string) []string {
+	var tokens []string
+	runes := []rune(rule)
+	for i := 0; i < len(runes); {
+		start := i
+		switch {
+		case unicode.IsSpace(runes[i]):
+			i++
+			continue
+		case unicode.IsLetter(runes[i]):
+			for i < len(runes) && unicode.IsLetter(runes[i]) {
+				i++
+			}
+		case unicode.IsDigit(runes[i]):
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+		case runes[i] == '.' && i+1 < len(runes) && runes[i+1] == '.',
+			runes[i] == '!' && i+1 < len(runes) && runes[i+1] == '=':
+			i += 2
+		default:
+			i++
+		}
+		tokens = append(tokens, string(runes[start:i]))
+	}
+	return tokens
+}
+
+// Returns the next token without consuming it.
+func (parser *pluralParser) peek() string {
+	if parser.pos < len(parser.tokens) {
+		return parser.tokens[parser.pos]
+	}
+	return ""
+}
+
+// Consumes the next token if it is the expected one.
+func (parser *pluralParser) accept(token string) bool {
+	if parser.peek() == token {
+		parser.pos++
+		return true
+	}
+	return false
+}
+
+// Parses: operand [("mod" | "%") value] ("=" | "!=" | "is" ["not"] | ["not"] ("in" | "within")) ranges
+func (parser *pluralParser) relation() (pluralRelation, error) {
+	var relation pluralRelation
+	switch operand := parser.peek(); operand {
+	case "n", "i", "v", "w", "f", "t", "c", "e":
+		relation.operand = operand
+		parser.pos++
+	default:
+		return relation, fmt.Errorf("expected operand, got %q", operand)
+	}
+	if parser.accept("mod") || parser.accept("%") {
+		modulus, err := parser.value()
+		if err != nil {
+			return relation, err
+		}
+		if modulus == 0 {
+			return relation, fmt.Errorf("modulus cannot be zero")
+		}
+		relation.modulus = modulus
+	}
+
+	switch {
+	case parser.accept("="):
+	case parser.accept("!="):
+		relation.negated = true
+	case parser.accept("is"):
+		relation.negated = parser.accept("not")
+		value, err := parser.value()
+		if err != nil {
+			return relation, err
+		}
+		relation.ranges = []pluralRange{{value, value}}
+		return relation, nil
+	default:
+		relation.negated = parser.accept("not")
+		if parser.accept("within") {
+			relation.within = true
+		} else if !parser.accept("in") {
+			return relation, fmt.Errorf("expected operator, got %q", parser.peek())
+		}
+	}
+
+	for {
+		from, err := parser.value()
+		if err != nil {
+			return relation, err
+		}
+		to := from
+		if parser.accept("..") {
+			if to, err = parser.value(); err != nil {
+				return relation, err
+			}
+		}
+		relation.ranges = append(relation.ranges, pluralRange{from, to})
+		if !parser.accept(",") {
+			break
+		}
+	}
+	return relation, nil
+}
+
+// Parses a single integer value.
+func (parser *pluralParser) value() (float64, error) {
+	token := parser.peek()
+	value, err := strconv.ParseUint(token, 10, 63)
+	if err != nil {
+		return 0, fmt.Errorf("expected number, got %q", token)
+	}
+	parser.pos++
+	return float64(value), nil
+}
@@ -0,0 +1,118 @@
+package humanize
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestPluralRules_Category(t *testing.T) {
+	rules := map[string]map[string]string{
+		"ru": {
+			"one":  "v = 0 and i % 10 = 1 and i % 100 != 11",
+			"few":  "v = 0 and i % 10 = 2..4 and i % 100 != 12..14",
+			"many": "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14",
+		},
+		"ar": {
+			"zero": "n = 0",
+			"one":  "n = 1",
+			"two":  "n = 2",
+			"few":  "n % 100 = 3..10",
+			"many": "n % 100 = 11..99",
+		},
+		"sl": {
+			"one": "v = 0 and i % 100 = 1",
+			"two": "v = 0 and i % 100 = 2",
+			"few": "v = 0 and i % 100 = 3..4 or v != 0",
+		},
+		"fr": {
+			"one": "i = 0,1 @integer 0, 1 @decimal 0.0~1.5",
+		},
+		"lv": {
+			"zero": "n % 10 = 0 or n % 100 = 11..19 or v = 2 and f % 100 = 11..19",
+			"one":  "n % 10 = 1 and n % 100 != 11 or v = 2 and f % 10 = 1 and f % 100 != 11 or v != 2 and f % 10 = 1",
+		},
+		"x": {
+			"one": "n within 0..2 and n is not 2",
+			"few": "n not in 3..5, 8 and n mod 2 is 1",
+		},
+	}
+	cases := map[string]map[string]string{
+		"ru": {"1": "one", "21": "one", "11": "many", "2": "few", "24": "few", "14": "many", "5": "many", "1.5": "other"},
+		"ar": {"0": "zero", "1": "one", "2": "two", "3": "few", "110": "few", "11": "many", "100": "other", "0.5": "other"},
+		"sl": {"1": "one", "101": "one", "2": "two", "3": "few", "1.5": "few", "5": "other"},
+		"fr": {"0": "one", "1.5": "one", "2": "other"},
+		"lv": {"10": "zero", "1": "one", "0.1": "one", "0.11": "zero", "2": "other"},
+		"x":  {"1.5": "one", "2": "other", "7": "few", "5": "other", "9": "few"},
+	}
+
+	for lang, caseList := range cases {
+		compiled, err := compilePluralRules(rules[lang])
+		if err != nil {
+			t.Fatalf("Compilation of %q rules failed with error: %s", lang, err)
+		}
+		for number, expected := range caseList {
+			if category := compiled.category(testPluralOperands(number)); category != expected {
+				t.Errorf("%s: expected '%s' for %s, got '%s'.", lang, expected, number, category)
+			}
+		}
+	}
+}
+
+// Returns the operands of a number in decimal notation, e.g. "1.50", with its visible fraction digits.
+func testPluralOperands(number string) pluralOperands {
+	integer, fraction, _ := strings.Cut(number, ".")
+	operands := pluralOperands{}
+	operands.n, _ = strconv.ParseFloat(number, 64)
+	operands.i, _ = strconv.ParseInt(integer, 10, 64)
+	if fraction != "" {
+		trimmed := strings.TrimRight(fraction, "0")
+		operands.v, operands.w = int64(len(fraction)), int64(len(trimmed))
+		operands.f, _ = strconv.ParseInt(fraction, 10, 64)
+		operands.t, _ = strconv.ParseInt("0"+trimmed, 10, 64)
+	}
+	return operands
+}
+
+func TestCompilePluralRules_Incorrect(t *testing.T) {
+	cases := []string{
+		"",
+		"@integer 1",
+		"x = 1",
+		"n = ",
+		"n % 0 = 1",
+		"n between 1..2",
+		"n = 1 and",
+		"n = 1 1",
+	}
+	for _, rule := range cases {
+		if _, err := compilePluralRules(map[string]string{"one": rule}); err == nil {
+			t.Errorf("Compilation of %q succeeded where it should have failed.", rule)
+		}
+	}
+}
+
+func TestHumanizer_formatCount(t *testing.T) {
+	humanizer, err := New("pl")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	forms := map[string]string{
+		"one":   "rok",
+		"few":   "%d lata",
+		"other": "%d lat",
+	}
+	cases := map[int64]string{
+		1:   "rok",
+		2:   "2 lata",
+		5:   "5 lat", // Missing form, falls back to "other".
+		12:  "12 lat",
+		22:  "22 lata",
+		101: "101 lat",
+	}
+	for count, expected := range cases {
+		if formatted := humanizer.formatCount(count, forms); formatted != expected {
+			t.Errorf("Expected '%s', got '%s'.", expected, formatted)
+		}
+	}
+}
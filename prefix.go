@@ -1,12 +1,10 @@
 package humanize
 
 import (
-	"fmt"
 	"math"
 	"math/big"
 	"regexp"
 	"sort"
-	"strconv"
 	"strings"
 )
 
@@ -68,9 +66,23 @@ func (humanizer *Humanizer) preparePrefixes() {
 		prefixes = append(prefixes, humanizer.allPrefixes[i].long)
 		prefixes = append(prefixes, humanizer.allPrefixes[i].short)
 	}
-	// Regexp will match: number, optional coma or dot, optional second number, optional space, optional suffix.
+	// Numbers are grouped and separated as in the output, e.g. "1,024.5" in English and "1.024,5" in German. Dot is
+	// accepted as the decimal separator as well, unless it groups the digits.
+	group, decimal, indian := humanizer.numberSymbols()
+	humanizer.groupSymbol = group
+	integer := `[0-9]+`
+	if group != "" && indian {
+		integer = `[0-9]{1,2}(?:` + regexp.QuoteMeta(group) + `[0-9]{2})*` + regexp.QuoteMeta(group) + `[0-9]{3}|[0-9]+`
+	} else if group != "" {
+		integer = `[0-9]{1,3}(?:` + regexp.QuoteMeta(group) + `[0-9]{3})+|[0-9]+`
+	}
+	decimals := regexp.QuoteMeta(decimal)
+	if decimal != "." && group != "." {
+		decimals = `(?:` + decimals + `|\.)`
+	}
+	// Regexp will match: number, optional decimal separator, optional decimals, optional space, optional suffix.
 	humanizer.prefixInputRe = regexp.MustCompile(
-		`([0-9]+)[.,]?([0-9]*?) ?(` + strings.Join(prefixes, "|") + `)?$`)
+		`^(` + integer + `)(?:` + decimals + `([0-9]*))? ?(` + strings.Join(prefixes, "|") + `)?$`)
 }
 
 // Performs the actual prefixing.
@@ -84,24 +96,23 @@ func (humanizer *Humanizer) prefix(value float64, decimals int, threshold int64,
 	}
 	// If value falls within ignored range then just format it.
 	if value <= float64(threshold) && value >= 10.0/float64(threshold) {
-		return trimZeroes(strconv.FormatFloat(value, 'f', decimals, 64))
+		return humanizer.formatDecimal(value, decimals)
 	}
 	// Find most appropriate prefix.
 	i := sort.Search(len(prefixes), func(i int) bool {
 		return prefixes[i].approxValue < value
 	})
 	if i == len(prefixes) { // prefixDef not found.
-		return trimZeroes(strconv.FormatFloat(value, 'f', decimals, 64))
+		return humanizer.formatDecimal(value, decimals)
 	}
 
-	// For prefixing the approximate value should be enough.
-	convertedValue := trimZeroes(
-		strconv.FormatFloat(value/prefixes[i].approxValue, 'f', decimals, 64))
+	// For prefixing the approximate value should be enough. Digits are not grouped, e.g. "1000µ".
+	convertedValue := humanizer.formatUngrouped(value/prefixes[i].approxValue, decimals)
 
 	if short {
 		return convertedValue + prefixes[i].short
 	}
-	return convertedValue + " " + prefixes[i].long
+	return convertedValue + " " + humanizer.provider.prefixes[prefixes[i].short]
 }
 
 // BitPrefixFast is a convenience wrapper over BitPrefix.
@@ -136,17 +147,40 @@ func (humanizer *Humanizer) BitPrefix(value float64, decimals int, threshold int
 	return humanizer.prefix(value, decimals, threshold, short, true)
 }
 
-// ParsePrefix will return a number as parsed from input string.
+// Regular expression matching the numbers in the input of ParsePrefix, with the optional space after them.
+var prefixNumberRe = regexp.MustCompile(`[0-9]+[.,]?[0-9]* ?`)
+
+// ParsePrefix will return a number as parsed from input string. Digits are grouped and decimals separated as in
+// the locale, e.g. "1,024.5k" in English and "1.024,5k" in German, so that the output of HumanizeNumber and the
+// prefixing functions can be parsed back.
 func (humanizer *Humanizer) ParsePrefix(input string) (*big.Float, error) {
-	matched := humanizer.prefixInputRe.FindStringSubmatch(strings.TrimSpace(input))
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return new(big.Float), &ParseError{Input: input, Err: ErrEmptyInput}
+	}
+	offset := strings.Index(input, trimmed)
+	matched := humanizer.prefixInputRe.FindStringSubmatch(trimmed)
 	// 0 - full match, 1 - number, 2 - decimal, 3 - suffix
 	if len(matched) != 4 {
-		return new(big.Float), fmt.Errorf("cannot parse %q", input)
+		// Number followed by an unknown suffix, e.g. "5 apples". Malformed numbers, e.g. "1,5k" in English, are syntax
+		// errors.
+		if numbers := prefixNumberRe.FindAllStringIndex(trimmed, -1); numbers != nil {
+			end := numbers[len(numbers)-1][1]
+			if !humanizer.prefixInputRe.MatchString("1" + trimmed[end:]) {
+				return new(big.Float), &ParseError{Input: input, Offset: offset + end, Token: trimmed[end:],
+					Err: ErrUnknownUnit}
+			}
+		}
+		return new(big.Float), &ParseError{Input: input, Offset: offset, Token: trimmed, Err: ErrSyntax}
 	}
 
 	// Parse first two groups as a float.
 	// This can only fail if the regexp is wrong and allows non numbers.
-	number, _ := new(big.Float).SetString(matched[1] + "." + matched[2])
+	integer := matched[1]
+	if humanizer.groupSymbol != "" {
+		integer = strings.ReplaceAll(integer, humanizer.groupSymbol, "")
+	}
+	number, _ := new(big.Float).SetString(integer + "." + matched[2])
 
 	// No suffix, no multiplication.
 	if matched[3] == "" {
@@ -161,5 +195,6 @@ func (humanizer *Humanizer) ParsePrefix(input string) (*big.Float, error) {
 	}
 
 	// No prefix was found. This should never happen as the regexp covers all units.
-	return new(big.Float), fmt.Errorf("can't match prefix for %q", matched[3])
+	return new(big.Float), &ParseError{Input: input, Offset: offset + len(trimmed) - len(matched[3]), Token: matched[3],
+		Err: ErrUnknownUnit}
 }
@@ -1,6 +1,7 @@
 package humanize
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 )
@@ -17,7 +18,7 @@ func TestHumanizer_Prefix(t *testing.T) {
 		"23 mega": humanizer.SiPrefix(22843853, 0, 1000, false),
 		"1.44M":   humanizer.SiPrefix(1440000, 2, 1000, true),
 		"5.3µ":    humanizer.SiPrefix(0.00000534, 1, 100, true),
-		"2345":    humanizer.SiPrefix(2345, 1, 10000, true),
+		"2,345":   humanizer.SiPrefix(2345, 1, 10000, true), // Big values are grouped.
 		"1Y":      humanizer.SiPrefix(1000000000001000000000000, 1, 1000, true),
 		// Too low threshold.
 		"1": humanizer.SiPrefix(1, 1, 1, true),
@@ -25,6 +26,7 @@ func TestHumanizer_Prefix(t *testing.T) {
 		"174.5k": humanizer.SiPrefixFast(174512),
 		"28M":    humanizer.SiPrefixFast(28000000),
 		"5.1m":   humanizer.SiPrefixFast(0.005123),
+		"1000µ":  humanizer.SiPrefixFast(0.001), // Prefixed values are not grouped.
 		"175":    humanizer.SiPrefixFast(175),
 		"1k":     humanizer.SiPrefixFast(1024),
 		// Bit prefixes.
@@ -32,7 +34,7 @@ func TestHumanizer_Prefix(t *testing.T) {
 		"21 tebi": humanizer.BitPrefix(22823452343853, 0, 1000, false),
 		"1.44Mi":  humanizer.BitPrefix(1509949, 2, 1000, true),
 		// Fast bit prefixes.
-		"1001":   humanizer.BitPrefixFast(1001), // Too small.
+		"1,001":  humanizer.BitPrefixFast(1001), // Too small.
 		"26.7Mi": humanizer.BitPrefixFast(28000000),
 		"0.01":   humanizer.BitPrefixFast(0.005123), // prefixDef not found.
 	}
@@ -44,6 +46,30 @@ func TestHumanizer_Prefix(t *testing.T) {
 	}
 }
 
+func TestHumanizer_Prefix_DecimalSeparator(t *testing.T) {
+	cases := map[string][4]string{
+		"pl":    {"1,5k", "1,44 mega", "12\u00a0345", "2k"},
+		"de-CH": {"1.5k", "1.44 mega", "12’345", "2k"},
+		"en-IN": {"1.5k", "1.44 mega", "12,345", "2k"},
+	}
+
+	for lang, expected := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		humanized := [4]string{
+			humanizer.SiPrefix(1500, 1, 1000, true),
+			humanizer.SiPrefix(1440000, 2, 1000, false),
+			humanizer.SiPrefix(12345, 0, 100000, true), // Too small, grouped.
+			humanizer.SiPrefix(2000.01, 1, 1000, true),
+		}
+		if humanized != expected {
+			t.Errorf("Expected '%s', got '%s'.", expected, humanized)
+		}
+	}
+}
+
 func TestHumanizer_ParsePrefix(t *testing.T) {
 	humanizer, err := New("en")
 	if err != nil {
@@ -85,6 +111,98 @@ func TestHumanizer_ParsePrefix(t *testing.T) {
 	}
 }
 
+func TestHumanizer_Prefix_Localized(t *testing.T) {
+	cases := map[string][3]string{
+		"ru": {"23 мега", "5 милли", "4 киби"},
+		"uk": {"23 мега", "5 мілі", "4 кібі"},
+		"pl": {"23 mega", "5 mili", "4 kibi"},
+		"de": {"23 mega", "5 milli", "4 kibi"},
+	}
+
+	for lang, expected := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		humanized := [3]string{
+			humanizer.SiPrefix(22843853, 0, 1000, false),
+			humanizer.SiPrefix(0.005, 0, 1000, false),
+			humanizer.BitPrefix(4096, 0, 1000, false),
+		}
+		if humanized != expected {
+			t.Errorf("Expected '%s', got '%s'.", expected, humanized)
+		}
+		// Long prefixes have to be parsed back.
+		for i, parsedExpected := range []string{"23000000", "0.005", "4096"} {
+			parsed, err := humanizer.ParsePrefix(expected[i])
+			if err != nil {
+				t.Errorf("Error parsing '%s': %s", expected[i], err)
+			} else if parsed.Text('g', 10) != parsedExpected {
+				t.Errorf("Expected '%s', got '%s'.", parsedExpected, parsed.Text('g', 10))
+			}
+		}
+	}
+}
+
+func TestHumanizer_ParsePrefix_RoundTrip(t *testing.T) {
+	// Prefixed values have to be parsed back in every language.
+	for lang := range languages {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		cases := map[string]string{
+			humanizer.SiPrefix(12345678, 0, 100000000, true): "12345678", // Grouped.
+			humanizer.HumanizeNumber(1234567.5, 1):           "1234567.5",
+			humanizer.SiPrefix(1500, 1, 1000, true):          "1500",
+			humanizer.SiPrefix(2500000, 1, 1000, false):      "2500000",
+			humanizer.SiPrefixFast(0.001):                    "0.001", // Prefixed values are not grouped.
+			humanizer.BitPrefix(1<<30, 0, 1000, false):       "1073741824",
+		}
+		for humanized, expected := range cases {
+			parsed, err := humanizer.ParsePrefix(humanized)
+			if err != nil {
+				t.Errorf("%s: error parsing '%s': %s", lang, humanized, err)
+			} else if parsed.Text('g', 10) != expected {
+				t.Errorf("%s: expected '%s' for '%s', got '%s'.", lang, expected, humanized, parsed.Text('g', 10))
+			}
+		}
+	}
+}
+
+func TestHumanizer_ParsePrefix_Grouped(t *testing.T) {
+	cases := []struct {
+		lang, input, expected string
+	}{
+		{"en", "12,345,678", "12345678"},
+		{"en", "2,500,000Y", "2.5e+30"},
+		{"de", "1.500", "1500"},
+		{"de-CH", "12’345", "12345"},
+		{"en-IN", "12,34,567", "1234567"},
+		{"pl", "1\u00a0500", "1500"},
+		{"fr", "1\u00a0500 kilo", "1500000"},
+	}
+	for _, testCase := range cases {
+		humanizer, err := New(testCase.lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		parsed, err := humanizer.ParsePrefix(testCase.input)
+		if err != nil {
+			t.Errorf("%s: error parsing '%s': %s", testCase.lang, testCase.input, err)
+		} else if parsed.Text('g', 10) != testCase.expected {
+			t.Errorf("%s: expected '%s', got '%s'.", testCase.lang, testCase.expected, parsed.Text('g', 10))
+		}
+	}
+	// Numbers grouped differently than in the locale are rejected.
+	for lang, input := range map[string]string{"en": "1,5", "de": "1.5k", "pl": "1,500,000"} {
+		humanizer, _ := New(lang)
+		if _, err := humanizer.ParsePrefix(input); !errors.Is(err, ErrSyntax) {
+			t.Errorf("%s: expected '%v' for '%s', got '%v'.", lang, ErrSyntax, input, err)
+		}
+	}
+}
+
 func TestHumanizer_ParsePrefix_Incorrect(t *testing.T) {
 	humanizer, err := New("en")
 	if err != nil {
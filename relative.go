@@ -0,0 +1,169 @@
+package humanize
+
+// Calendar relative dates humanization functions.
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// RelativeDateNow is a convenience method returning the date relative to today, e.g. "yesterday".
+func (humanizer *Humanizer) RelativeDateNow(date time.Time, loc *time.Location) string {
+	return humanizer.RelativeDate(humanizer.clock.Now(), date, loc)
+}
+
+// RelativeDate will return the date relative to the reference date, using the calendar days in the given location
+// (or the location of the reference date, if nil), e.g.:
+//
+//	"yesterday", "tomorrow", "last Monday", "next Friday", "next week", "last month", "next year"
+//
+// Dates too far away, or in languages without relative words, are humanized with TimeDiff.
+func (humanizer *Humanizer) RelativeDate(reference, date time.Time, loc *time.Location) string {
+	words := humanizer.provider.times.relative
+	if words == nil {
+		return humanizer.TimeDiff(reference, date, false)
+	}
+	if loc == nil {
+		loc = reference.Location()
+	}
+	referenceDay, day := civilDay(reference.In(loc)), civilDay(date.In(loc))
+	days := daysBetween(referenceDay, day)
+	direction := 0 // Index of the past or future words.
+	if days > 0 {
+		direction = 1
+	}
+
+	if days >= -6 && days <= 6 {
+		return words.day(days, day.Weekday())
+	}
+	weeks := daysBetween(startOfWeek(referenceDay, words.firstDay), startOfWeek(day, words.firstDay)) / 7
+	if weeks == -1 || weeks == 1 {
+		return words.weeks[direction]
+	}
+	if months := monthsOf(day) - monthsOf(referenceDay); months == -1 || months == 1 {
+		return words.months[direction]
+	}
+	if years := day.Year() - referenceDay.Year(); years == -1 || years == 1 {
+		return words.years[direction]
+	}
+	return humanizer.TimeDiff(reference, date, false)
+}
+
+// ParseRelative will parse a date relative to the reference date, the inverse of TimeDiff and RelativeDate, e.g.:
+//
+//	"now", "in 2 days", "3 hours ago", "yesterday", "next Friday", "za 3 dni"
+//
+// Durations are parsed strictly, see ParseDurationStrict, and added to the reference date with the fixed lengths of
+// months and years, as used by TimeDiff. Relative days keep the time of the reference date.
+func (humanizer *Humanizer) ParseRelative(reference time.Time, input string) (time.Time, error) {
+	if strings.TrimSpace(input) == "" {
+		return time.Time{}, &ParseError{Input: input, Err: ErrEmptyInput}
+	}
+	normalized := foldAccents(strings.Join(strings.Fields(input), " "))
+	times := humanizer.provider.times
+	if strings.EqualFold(normalized, foldAccents(times.now)) {
+		return reference, nil
+	}
+	if words := times.relative; words != nil {
+		if date, ok := words.parse(reference, normalized); ok {
+			return date, nil
+		}
+	}
+	templates := []struct {
+		format string
+		sign   time.Duration
+	}{{times.future, 1}, {times.past, -1}}
+	for _, template := range templates {
+		start, end, ok := templateBounds(input, template.format)
+		if !ok {
+			continue
+		}
+		duration, err := humanizer.ParseDurationStrict(input[start:end])
+		if err != nil {
+			// Position the error in the whole input.
+			var parseErr *ParseError
+			if errors.As(err, &parseErr) {
+				parseErr.Input, parseErr.Offset = input, start+parseErr.Offset
+			}
+			return time.Time{}, err
+		}
+		return reference.Add(template.sign * duration), nil
+	}
+	trimmed := strings.TrimSpace(input)
+	return time.Time{}, &ParseError{Input: input, Offset: strings.Index(input, trimmed), Token: trimmed, Err: ErrSyntax}
+}
+
+// parse will return the date of the relative words matching the input, e.g. the last Friday for "last Friday".
+func (words *relativeWords) parse(reference time.Time, input string) (time.Time, bool) {
+	matches := func(word string) bool {
+		return word != "" && strings.EqualFold(input, foldAccents(word))
+	}
+	switch {
+	case matches(words.today):
+		return reference, true
+	case matches(words.yesterday):
+		return reference.AddDate(0, 0, -1), true
+	case matches(words.tomorrow):
+		return reference.AddDate(0, 0, 1), true
+	}
+	for direction, sign := range [2]int{-1, 1} {
+		for weekday, word := range words.weekdays[direction] {
+			if !matches(word) {
+				continue
+			}
+			// The closest such weekday, a week away for the reference's own weekday.
+			days := (sign*(weekday-int(reference.Weekday())) + 7) % 7
+			if days == 0 {
+				days = 7
+			}
+			return reference.AddDate(0, 0, sign*days), true
+		}
+		switch {
+		case matches(words.weeks[direction]):
+			return reference.AddDate(0, 0, sign*7), true
+		case matches(words.months[direction]):
+			return reference.AddDate(0, sign, 0), true
+		case matches(words.years[direction]):
+			return reference.AddDate(sign, 0, 0), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// day returns the word for the day, up to 6 days from today, e.g. "yesterday" or "next Friday".
+func (words *relativeWords) day(days int, weekday time.Weekday) string {
+	switch {
+	case days == 0:
+		return words.today
+	case days == -1:
+		return words.yesterday
+	case days == 1:
+		return words.tomorrow
+	case days < 0:
+		return words.weekdays[0][weekday]
+	}
+	return words.weekdays[1][weekday]
+}
+
+// civilDay returns the midnight of the date's calendar day, in UTC, so that days can be counted without the daylight
+// saving time changes.
+func civilDay(date time.Time) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween returns the number of days between two civil days.
+func daysBetween(start, end time.Time) int {
+	return int(end.Sub(start) / (24 * time.Hour))
+}
+
+// startOfWeek returns the first day of the week of the civil day.
+func startOfWeek(day time.Time, firstDay time.Weekday) time.Time {
+	return day.AddDate(0, 0, -int((day.Weekday()-firstDay+7)%7))
+}
+
+// monthsOf returns the number of months since the beginning of the era.
+func monthsOf(day time.Time) int {
+	return day.Year()*12 + int(day.Month()) - 1
+}
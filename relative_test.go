@@ -0,0 +1,171 @@
+package humanize
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanizer_RelativeDate(t *testing.T) {
+	// Wednesday.
+	reference := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+	cases := map[string]map[time.Time]string{
+		"en": {
+			time.Date(2017, 3, 15, 23, 59, 0, 0, time.UTC): "today",
+			time.Date(2017, 3, 14, 0, 0, 0, 0, time.UTC):   "yesterday",
+			time.Date(2017, 3, 16, 8, 0, 0, 0, time.UTC):   "tomorrow",
+			time.Date(2017, 3, 10, 12, 0, 0, 0, time.UTC):  "last Friday",
+			time.Date(2017, 3, 21, 12, 0, 0, 0, time.UTC):  "next Tuesday",
+			time.Date(2017, 3, 8, 12, 0, 0, 0, time.UTC):   "last week",
+			time.Date(2017, 3, 26, 12, 0, 0, 0, time.UTC):  "next week",
+			time.Date(2017, 2, 20, 12, 0, 0, 0, time.UTC):  "last month",
+			time.Date(2017, 4, 30, 12, 0, 0, 0, time.UTC):  "next month",
+			time.Date(2016, 6, 1, 12, 0, 0, 0, time.UTC):   "last year",
+			time.Date(2018, 11, 1, 12, 0, 0, 0, time.UTC):  "next year",
+			time.Date(2017, 8, 1, 12, 0, 0, 0, time.UTC):   "in 4 months",
+			time.Date(2014, 3, 1, 12, 0, 0, 0, time.UTC):   "3 years ago",
+		},
+		"pl": {
+			time.Date(2017, 3, 15, 0, 0, 0, 0, time.UTC):  "dzisiaj",
+			time.Date(2017, 3, 14, 0, 0, 0, 0, time.UTC):  "wczoraj",
+			time.Date(2017, 3, 16, 0, 0, 0, 0, time.UTC):  "jutro",
+			time.Date(2017, 3, 11, 12, 0, 0, 0, time.UTC): "w zeszłą sobotę",
+			time.Date(2017, 3, 20, 12, 0, 0, 0, time.UTC): "w przyszły poniedziałek",
+			time.Date(2017, 3, 8, 12, 0, 0, 0, time.UTC):  "w zeszłym tygodniu",
+			time.Date(2017, 4, 30, 12, 0, 0, 0, time.UTC): "w przyszłym miesiącu",
+			time.Date(2016, 6, 1, 12, 0, 0, 0, time.UTC):  "w zeszłym roku",
+		},
+		// Languages without relative words fall back to TimeDiff.
+		"de": {
+			time.Date(2017, 3, 14, 10, 0, 0, 0, time.UTC): "vor 1 Tag",
+		},
+	}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for date, expected := range caseList {
+			if humanized := humanizer.RelativeDate(reference, date, nil); humanized != expected {
+				t.Errorf("%s: expected '%s' for %s, got '%s'.", lang, expected, date, humanized)
+			}
+		}
+	}
+}
+
+func TestHumanizer_RelativeDate_Location(t *testing.T) {
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	warsaw, err := time.LoadLocation("Europe/Warsaw")
+	if err != nil {
+		t.Fatalf("Loading location failed with error: %s", err)
+	}
+	reference := time.Date(2017, 3, 15, 23, 30, 0, 0, time.UTC) // Already March 16th in Warsaw.
+	date := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	if humanized := humanizer.RelativeDate(reference, date, nil); humanized != "today" {
+		t.Errorf("Expected 'today', got '%s'.", humanized)
+	}
+	if humanized := humanizer.RelativeDate(reference, date, warsaw); humanized != "yesterday" {
+		t.Errorf("Expected 'yesterday', got '%s'.", humanized)
+	}
+	// Day with the daylight saving time change is still a single day.
+	reference = time.Date(2017, 3, 26, 0, 30, 0, 0, warsaw)
+	if humanized := humanizer.RelativeDate(reference, reference.Add(47*time.Hour), warsaw); humanized != "next Tuesday" {
+		t.Errorf("Expected 'next Tuesday', got '%s'.", humanized)
+	}
+	clock := NewFakeClock(time.Date(2017, 3, 15, 23, 30, 0, 0, time.UTC))
+	humanizer, err = New("en", WithClock(clock))
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	if humanized := humanizer.RelativeDateNow(clock.Now().Add(time.Hour), time.UTC); humanized != "tomorrow" {
+		t.Errorf("Expected 'tomorrow', got '%s'.", humanized)
+	}
+	if humanized := humanizer.RelativeDateNow(clock.Now().Add(time.Hour), warsaw); humanized != "today" {
+		t.Errorf("Expected 'today', got '%s'.", humanized)
+	}
+}
+
+func TestHumanizer_ParseRelative(t *testing.T) {
+	// Wednesday.
+	reference := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+	cases := map[string]map[string]time.Time{
+		"en": {
+			"now":                      reference,
+			"in 2 days":                reference.AddDate(0, 0, 2),
+			"3 hours ago":              reference.Add(-3 * time.Hour),
+			"In 1 hour and 30 minutes": reference.Add(90 * time.Minute),
+			"2 days, 5 hours ago":      reference.Add(-53 * time.Hour),
+			"today":                    reference,
+			"Yesterday":                reference.AddDate(0, 0, -1),
+			"tomorrow":                 reference.AddDate(0, 0, 1),
+			"next friday":              time.Date(2017, 3, 17, 10, 0, 0, 0, time.UTC),
+			"last Friday":              time.Date(2017, 3, 10, 10, 0, 0, 0, time.UTC),
+			"last wednesday":           time.Date(2017, 3, 8, 10, 0, 0, 0, time.UTC),
+			"next  week":               time.Date(2017, 3, 22, 10, 0, 0, 0, time.UTC),
+			"last month":               time.Date(2017, 2, 15, 10, 0, 0, 0, time.UTC),
+			"next year":                time.Date(2018, 3, 15, 10, 0, 0, 0, time.UTC),
+		},
+		"pl": {
+			"za 3 dni":              reference.AddDate(0, 0, 3),
+			"5 minut temu":          reference.Add(-5 * time.Minute),
+			"wczoraj":               reference.AddDate(0, 0, -1),
+			"w przyszły piątek":     time.Date(2017, 3, 17, 10, 0, 0, 0, time.UTC),
+			"w zeszły poniedziałek": time.Date(2017, 3, 13, 10, 0, 0, 0, time.UTC),
+		},
+		"de": {
+			"vor 2 Tagen": reference.AddDate(0, 0, -2),
+			"jetzt":       reference,
+		},
+	}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for input, expected := range caseList {
+			parsed, err := humanizer.ParseRelative(reference, input)
+			if err != nil {
+				t.Errorf("%s: parsing %q failed with error: %s", lang, input, err)
+			} else if !parsed.Equal(expected) {
+				t.Errorf("%s: expected '%s' for %q, got '%s'.", lang, expected, input, parsed)
+			}
+		}
+	}
+}
+
+func TestHumanizer_ParseRelative_RoundTrip(t *testing.T) {
+	reference := time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC)
+	for lang := range languages {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		offsets := []time.Duration{-3 * time.Hour, 5 * time.Minute, 50 * time.Hour, -70 * Day * time.Second,
+			// Singular forms can leave out the number, e.g. "za godzinę" in Polish.
+			time.Hour, -time.Hour, time.Second, time.Duration(Month * time.Second), -time.Duration(Month * time.Second)}
+		for _, offset := range offsets {
+			date := reference.Add(offset)
+			humanized := humanizer.TimeDiff(reference, date, true)
+			if parsed, err := humanizer.ParseRelative(reference, humanized); err != nil || !parsed.Equal(date) {
+				t.Errorf("%s: expected '%s' for %q, got '%s' (%v).", lang, date, humanized, parsed, err)
+			}
+		}
+	}
+}
+
+func TestHumanizer_ParseRelative_Errors(t *testing.T) {
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	for _, input := range []string{"", "2 days", "in soon", "last decade"} {
+		if _, err := humanizer.ParseRelative(time.Now(), input); err == nil {
+			t.Errorf("Expected an error for %q.", input)
+		}
+	}
+}
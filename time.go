@@ -4,25 +4,39 @@ package humanize
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
-// Time constants.
+// Time constants, in seconds.
 const (
-	Second   = 1
-	Minute   = 60
-	Hour     = 60 * Minute
-	Day      = 24 * Hour
-	Week     = 7 * Day
-	Month    = 30 * Day
-	Year     = 12 * Month
-	LongTime = 35 * Year
+	Nanosecond  = Microsecond / 1000
+	Microsecond = Millisecond / 1000
+	Millisecond = Second / 1000.0
+	Second      = 1
+	Minute      = 60
+	Hour        = 60 * Minute
+	Day         = 24 * Hour
+	Week        = 7 * Day
+	Month       = 30 * Day
+	Year        = 12 * Month
+	LongTime    = 35 * Year
 )
 
+// Length of a month, as used by the time ranges.
+const calendarMonth = Month * time.Second
+
+// secondsToDuration converts the time in seconds, e.g. Millisecond, into a duration.
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Round(seconds * float64(time.Second)))
+}
+
 // buildTimeInputRe will build a regular expression to match all possible time inputs.
 func (humanizer *Humanizer) buildTimeInputRe() {
 	// Get all possible time units.
@@ -30,77 +44,391 @@ func (humanizer *Humanizer) buildTimeInputRe() {
 	for unit := range humanizer.provider.times.units {
 		units = append(units, unit)
 	}
-	// Regexp will match: number, optional coma or dot, optional second number, unit name
-	humanizer.timeInputRe = regexp.MustCompile("([0-9]+)[.,]?([0-9]*?) (" + strings.Join(units, "|") + ")")
+	// Longest units go first, so that e.g. "mes" is not matched as "m".
+	sort.Slice(units, func(i, j int) bool {
+		if len(units[i]) != len(units[j]) {
+			return len(units[i]) > len(units[j])
+		}
+		return units[i] < units[j]
+	})
+	humanizer.timeUnits = units
+	quoted := make([]string, len(units))
+	for i, unit := range units {
+		quoted[i] = regexp.QuoteMeta(unit)
+	}
+	// Regexp will match: number, optional coma or dot, optional second number, optional space, unit name
+	humanizer.timeInputRe = regexp.MustCompile(`(?i)([0-9]+)[.,]?([0-9]*?)\s*(` + strings.Join(quoted, "|") + ")")
 }
 
-// humanizeDuration will return a humanized form of time duration.
-func (humanizer *Humanizer) humanizeDuration(seconds int64, precise bool) string {
-	if seconds == 0 {
-		return humanizer.provider.times.now
+// DurationOptions control how a duration is humanized.
+type DurationOptions struct {
+	// Whether an exact description should be returned instead of a rough approximation.
+	Precise bool
+	// Whether months and years should be counted on the calendar, instead of using their fixed lengths
+	// (Month and Year constants). Only used when both dates are known, e.g. by TimeDiffWith.
+	Calendar bool
+	// Rounding of the approximate durations. When nil, approximate durations are truncated to the largest unit.
+	Approximation *Approximation
+	// Maximum number of parts of a precise duration, e.g. 2 for "3 months and 1 day". Zero means no limit.
+	MaxParts int
+	// Smallest unit (in seconds) shown in a precise duration, e.g. Minute or Millisecond. Zero means all units.
+	// Units skipped in the precise mode, e.g. weeks, are shown when they are the smallest one. Time difference shorter
+	// than the smallest unit is "now", unless it is rounded up.
+	SmallestUnit float64
+	// Rounding of the remainder dropped from a precise duration, because of MaxParts or SmallestUnit.
+	Rounding Rounding
+	// Style of the units. Styles not defined by the language fall back to StyleLong.
+	Style Style
+}
+
+// Style of the humanized units.
+type Style int
+
+const (
+	StyleLong   Style = iota // E.g. "3 hours".
+	StyleShort               // E.g. "3 hr".
+	StyleNarrow              // E.g. "3h".
+)
+
+// Rounding defines how the remainder dropped from a precise duration is rounded.
+type Rounding int
+
+const (
+	RoundDown   Rounding = iota // Remainder is dropped.
+	RoundHalfUp                 // Last part is rounded up if the remainder is at least half of its unit.
+	RoundUp                     // Last part is rounded up if there is any remainder.
+)
+
+// roundsUp checks whether the remainder, being the given fraction of the unit, should be rounded up.
+func (rounding Rounding) roundsUp(fraction float64) bool {
+	switch rounding {
+	case RoundHalfUp:
+		return fraction >= 0.5
+	case RoundUp:
+		return fraction > 0
 	}
-	secondsLeft := seconds
-	if secondsLeft < 0 {
-		secondsLeft = -secondsLeft
+	return false
+}
+
+// Approximation defines how approximate durations are rounded and qualified. Thresholds are fractions of the
+// largest unit, e.g. 1 year and 3 months is 1.25 years:
+//
+//	below Over      -> "about 1 year"
+//	below Almost    -> "over 1 year"
+//	Almost or above -> "almost 2 years"
+//
+// Durations being a whole number of units are not qualified. Thresholds are clamped to the range from 0 to 1, with
+// Almost being at least Over. NaN thresholds fall back to the DefaultApproximation ones.
+type Approximation struct {
+	Over   float64
+	Almost float64
+}
+
+// clamped returns the approximation with the thresholds in the range from 0 to 1, with Almost being at least Over.
+func (approximation Approximation) clamped() Approximation {
+	clamp := func(threshold, fallback float64) float64 {
+		if math.IsNaN(threshold) {
+			return fallback
+		}
+		return math.Min(math.Max(threshold, 0), 1)
 	}
-	var humanized []string
+	over := clamp(approximation.Over, DefaultApproximation.Over)
+	return Approximation{Over: over, Almost: math.Max(clamp(approximation.Almost, DefaultApproximation.Almost), over)}
+}
 
-	for secondsLeft > 0 {
-		// Within all the ranges, find the one matching our time best (closest, but bigger).
-		rangeIndex := sort.Search(len(humanizer.provider.times.ranges), func(i int) bool {
-			// If we are in precise mode, and next range would be a fit but should be skipped, use this one.
-			if precise && i < len(humanizer.provider.times.ranges)-1 &&
-				humanizer.provider.times.ranges[i+1].upperLimit > secondsLeft &&
-				humanizer.provider.times.ranges[i+1].skipWhenPrecise {
-				return true
-			}
-			return humanizer.provider.times.ranges[i].upperLimit > secondsLeft
-		})
+// DefaultApproximation is the recommended approximation, e.g. 1 year and 9 months is "almost 2 years".
+var DefaultApproximation = Approximation{Over: 0.25, Almost: 0.75}
+
+// Single part of a humanized duration, e.g. "3 days".
+type durationPart struct {
+	rangeIndex int
+	count      int64
+}
 
-		// Select this unit range and convert actualTime to it.
-		unitRanges := humanizer.provider.times.ranges[rangeIndex]
-		actualTime := secondsLeft / unitRanges.divideBy // Integer division!
+// findTimeRange will find the range matching the time best (closest, but bigger).
+// Time exceeding all the ranges falls into the last one.
+func findTimeRange(ranges []timeRanges, duration time.Duration, precise bool) int {
+	index := sort.Search(len(ranges), func(i int) bool {
+		// If we are in precise mode, and next range would be a fit but should be skipped, use this one.
+		if precise && i < len(ranges)-1 && ranges[i+1].upperLimit > duration && ranges[i+1].skipWhenPrecise {
+			return true
+		}
+		return ranges[i].upperLimit > duration
+	})
+	if index == len(ranges) {
+		index--
+		for precise && index > 0 && ranges[index].skipWhenPrecise {
+			index--
+		}
+	}
+	return index
+}
+
+// durationParts will split the time into parts, using the fixed lengths of the given ranges.
+func (humanizer *Humanizer) durationParts(duration time.Duration, precise bool, ranges []timeRanges) []durationPart {
+	var parts []durationPart
+	for duration > 0 && len(ranges) > 0 {
+		// Select the unit range and convert the time to it.
+		rangeIndex := findTimeRange(ranges, duration, precise)
+		count := int64(duration / ranges[rangeIndex].divideBy) // Integer division!
+		if count == 0 {
+			break
+		}
+		parts = append(parts, durationPart{rangeIndex, count})
 
 		// Subtract the time span covered by this part.
-		secondsLeft -= actualTime * unitRanges.divideBy
-		// TODO: smarter imprecise mode.
+		duration -= time.Duration(count) * ranges[rangeIndex].divideBy
 		if !precise { // We don't care about the reminder.
-			secondsLeft = 0
+			break
 		}
+	}
+	return parts
+}
 
-		if actualTime == 1 { // Special case for singular unit.
-			humanized = append(humanized, unitRanges.singular)
-			continue
+// calendarDurationParts will split the time between the dates into parts, counting months and years on the
+// calendar in the location of the start date. Shorter units keep their fixed lengths.
+func (humanizer *Humanizer) calendarDurationParts(startDate, endDate time.Time, precise bool) []durationPart {
+	if endDate.Before(startDate) {
+		startDate, endDate = endDate, startDate
+	}
+	endDate = endDate.In(startDate.Location())
+	ranges := humanizer.provider.times.ranges
+
+	// Ranges with units being whole months are counted on the calendar.
+	calendarFrom := firstCalendarRange(ranges)
+	var parts []durationPart
+	months := monthsBetween(startDate, endDate)
+	used := int64(0) // Months covered by the parts.
+	for i := len(ranges) - 1; i >= calendarFrom; i-- {
+		unitMonths := int64(ranges[i].divideBy / calendarMonth)
+		if count := (months - used) / unitMonths; count > 0 {
+			parts = append(parts, durationPart{i, count})
+			used += count * unitMonths
+			if !precise {
+				return parts
+			}
+		}
+	}
+
+	// The remainder is shorter than a month. Count days on the calendar as well, so that they are not affected
+	// by the daylight saving time changes.
+	anchor := addMonths(startDate, int(used))
+	days := int(endDate.Sub(anchor) / (24 * time.Hour))
+	for days > 0 && anchor.AddDate(0, 0, days).After(endDate) {
+		days--
+	}
+	for !anchor.AddDate(0, 0, days+1).After(endDate) {
+		days++
+	}
+	remainder := time.Duration(days)*Day*time.Second + endDate.Sub(anchor.AddDate(0, 0, days))
+	return append(parts, humanizer.durationParts(remainder, precise, ranges[:calendarFrom])...)
+}
+
+// preciseDurationParts will split the time into parts, limited and rounded according to the options.
+func (humanizer *Humanizer) preciseDurationParts(duration time.Duration, options DurationOptions) []durationPart {
+	ranges := humanizer.provider.times.ranges
+	kept := humanizer.limitDurationParts(humanizer.durationParts(duration, true, ranges), options)
+	keptDuration := time.Duration(0)
+	for _, part := range kept {
+		keptDuration += time.Duration(part.count) * ranges[part.rangeIndex].divideBy
+	}
+	unit := ranges[kept[len(kept)-1].rangeIndex].divideBy
+	if options.Rounding.roundsUp(float64(duration-keptDuration) / float64(unit)) {
+		// Rounded time is a whole number of units, so it is not rounded again.
+		if rounded := keptDuration + unit; rounded > keptDuration {
+			return humanizer.limitDurationParts(humanizer.durationParts(rounded, true, ranges), options)
+		}
+		// Rounded time does not fit in time.Duration, so it is counted in seconds.
+		nanoseconds := int64(keptDuration%time.Second + unit%time.Second)
+		seconds := int64(keptDuration/time.Second+unit/time.Second) + nanoseconds/int64(time.Second)
+		kept, _ = humanizer.longDurationParts(seconds, nanoseconds%int64(time.Second), options)
+	}
+	return kept
+}
+
+// preciseCalendarDurationParts will split the time between the dates into parts, counting months and years on the
+// calendar, limited and rounded according to the options.
+func (humanizer *Humanizer) preciseCalendarDurationParts(startDate, endDate time.Time,
+	options DurationOptions) []durationPart {
+	if endDate.Before(startDate) {
+		startDate, endDate = endDate, startDate
+	}
+	endDate = endDate.In(startDate.Location())
+	kept := humanizer.limitDurationParts(humanizer.calendarDurationParts(startDate, endDate, true), options)
+	keptEnd := humanizer.calendarPartsEnd(startDate, kept)
+	kept[len(kept)-1].count++
+	nextEnd := humanizer.calendarPartsEnd(startDate, kept)
+	kept[len(kept)-1].count--
+	if options.Rounding.roundsUp(float64(endDate.Sub(keptEnd)) / float64(nextEnd.Sub(keptEnd))) {
+		kept = humanizer.limitDurationParts(humanizer.calendarDurationParts(startDate, nextEnd, true), options)
+	}
+	return kept
+}
+
+// calendarPartsEnd returns the date the calendar parts lead to from the start date.
+func (humanizer *Humanizer) calendarPartsEnd(startDate time.Time, parts []durationPart) time.Time {
+	ranges := humanizer.provider.times.ranges
+	calendarFrom := firstCalendarRange(ranges)
+	months, remainder := int64(0), time.Duration(0)
+	for _, part := range parts {
+		if part.rangeIndex >= calendarFrom {
+			months += part.count * int64(ranges[part.rangeIndex].divideBy/calendarMonth)
+		} else {
+			remainder += time.Duration(part.count) * ranges[part.rangeIndex].divideBy
 		}
+	}
+	// Same as in calendarDurationParts, whole days are counted on the calendar.
+	day := Day * time.Second
+	return addMonths(startDate, int(months)).AddDate(0, 0, int(remainder/day)).Add(remainder % day)
+}
 
-		// Within the unit range, find the unit best fitting our actualTime (closest, but bigger).
-		searchTime := actualTime
-		if unitRanges.onlyLastDigitAfter != 0 && actualTime > unitRanges.onlyLastDigitAfter {
-			searchTime = actualTime % 10
+// limitDurationParts will drop the parts exceeding MaxParts or smaller than SmallestUnit. Units skipped in the precise
+// mode are used when SmallestUnit asks for them, e.g. 15 days are "2 weeks" with SmallestUnit of Week.
+// If no part is left, a zero count of the smallest allowed unit is returned, so that it can be rounded up.
+func (humanizer *Humanizer) limitDurationParts(parts []durationPart, options DurationOptions) []durationPart {
+	ranges := humanizer.provider.times.ranges
+	smallest := len(ranges) - 1
+	for i := range ranges {
+		if ranges[i].divideBy >= secondsToDuration(options.SmallestUnit) {
+			smallest = i
+			break
+		}
+	}
+	var kept []durationPart
+	dropped := time.Duration(0)
+	for _, part := range parts {
+		if options.MaxParts > 0 && len(kept) == options.MaxParts {
+			return kept
 		}
-		unitIndex := sort.Search(len(unitRanges.ranges), func(i int) bool {
-			return unitRanges.ranges[i].upperLimit > searchTime
-		})
-		timeRange := unitRanges.ranges[unitIndex]
+		if part.rangeIndex < smallest {
+			dropped += time.Duration(part.count) * ranges[part.rangeIndex].divideBy
+			continue
+		}
+		kept = append(kept, part)
+	}
+	// Parts never contain the skipped units, so the dropped ones are converted, e.g. 15 days into 2 weeks.
+	count := int64(0)
+	if ranges[smallest].skipWhenPrecise {
+		count = int64(dropped / ranges[smallest].divideBy)
+	}
+	if count > 0 || len(kept) == 0 {
+		kept = append(kept, durationPart{smallest, count})
+	}
+	return kept
+}
 
-		humanized = append(humanized, fmt.Sprintf(timeRange.format, actualTime))
+// approximateDuration will round the time to the largest unit of the given ranges.
+func (humanizer *Humanizer) approximateDuration(
+	duration time.Duration, ranges []timeRanges, approximation Approximation) (durationPart, durationQualifier) {
+	rangeIndex := findTimeRange(ranges, duration, false)
+	unit := ranges[rangeIndex].divideBy
+	return qualifyDuration(ranges, rangeIndex, int64(duration/unit), float64(duration%unit)/float64(unit), approximation)
+}
+
+// calendarApproximateDuration will round the time between the dates to the largest unit, counting months and years
+// on the calendar.
+func (humanizer *Humanizer) calendarApproximateDuration(
+	startDate, endDate time.Time, approximation Approximation) (durationPart, durationQualifier) {
+	if endDate.Before(startDate) {
+		startDate, endDate = endDate, startDate
+	}
+	endDate = endDate.In(startDate.Location())
+	ranges := humanizer.provider.times.ranges
+	calendarFrom := firstCalendarRange(ranges)
+
+	parts := humanizer.calendarDurationParts(startDate, endDate, false)
+	if len(parts) == 0 || parts[0].rangeIndex < calendarFrom {
+		// Shorter than any calendar unit.
+		return humanizer.approximateDuration(endDate.Sub(startDate), ranges[:calendarFrom], approximation)
+	}
+	// Fraction of the calendar unit following the counted ones.
+	unitMonths := int(ranges[parts[0].rangeIndex].divideBy / calendarMonth)
+	counted := addMonths(startDate, int(parts[0].count)*unitMonths)
+	next := addMonths(startDate, int(parts[0].count+1)*unitMonths)
+	fraction := float64(endDate.Sub(counted)) / float64(next.Sub(counted))
+	return qualifyDuration(ranges, parts[0].rangeIndex, parts[0].count, fraction, approximation)
+}
+
+// qualifyDuration will round the count of units, based on the fraction of the next unit.
+// Rounding up can roll over to a bigger unit, e.g. almost 12 months is "almost 1 year".
+func qualifyDuration(ranges []timeRanges, rangeIndex int, count int64, fraction float64,
+	approximation Approximation) (durationPart, durationQualifier) {
+	approximation = approximation.clamped()
+	switch {
+	case fraction <= 0:
+		return durationPart{rangeIndex, count}, qualifierExact
+	case fraction < approximation.Over:
+		return durationPart{rangeIndex, count}, qualifierAbout
+	case fraction < approximation.Almost:
+		return durationPart{rangeIndex, count}, qualifierOver
+	}
+	duration := time.Duration(count+1) * ranges[rangeIndex].divideBy
+	rangeIndex = findTimeRange(ranges, duration, false)
+	return durationPart{rangeIndex, int64(duration / ranges[rangeIndex].divideBy)}, qualifierAlmost
+}
+
+// firstCalendarRange returns the index of the first range counted on the calendar, i.e. with units being whole
+// months. Returns the number of ranges if there are none.
+func firstCalendarRange(ranges []timeRanges) int {
+	calendarFrom := len(ranges)
+	for calendarFrom > 0 && ranges[calendarFrom-1].divideBy >= calendarMonth &&
+		ranges[calendarFrom-1].divideBy%calendarMonth == 0 {
+		calendarFrom--
+	}
+	return calendarFrom
+}
+
+// joinDurationParts will format and join the parts in the given style, declined for the given context.
+func (humanizer *Humanizer) joinDurationParts(parts []durationPart, context durationContext, style Style) string {
+	if len(parts) == 0 {
+		return humanizer.provider.times.now
+	}
+	humanized := make([]string, len(parts))
+	for i, part := range parts {
+		forms := humanizer.provider.times.ranges[part.rangeIndex].forms[style]
+		if len(forms[durationStandalone]) == 0 {
+			forms = humanizer.provider.times.ranges[part.rangeIndex].forms[StyleLong]
+		}
+		humanized[i] = humanizer.formatCount(part.count, forms[context], forms[durationStandalone])
 	}
 
 	if len(humanized) == 1 {
 		return humanized[0]
 	}
-	return fmt.Sprintf(
-		"%s %s %s",
-		strings.Join(humanized[:len(humanized)-1], ", "),
-		humanizer.provider.times.remainderSep,
-		humanized[len(humanized)-1],
-	)
+	return strings.Join(humanized[:len(humanized)-1], humanizer.provider.times.partSeps[style]) +
+		humanizer.provider.times.remainderSeps[style] + humanized[len(humanized)-1]
+}
 
+// monthsBetween returns the number of whole calendar months between the dates.
+func monthsBetween(startDate, endDate time.Time) int64 {
+	months := int64(endDate.Year()-startDate.Year())*12 + int64(endDate.Month()-startDate.Month())
+	for months > 0 && addMonths(startDate, int(months)).After(endDate) {
+		months--
+	}
+	return months
+}
+
+// addMonths adds the months to the date. Unlike time.AddDate, the day is clamped to the end of the resulting
+// month, so e.g. one month after January 31st is the last day of February.
+func addMonths(date time.Time, months int) time.Time {
+	year, month, day := date.Date()
+	hour, minute, second := date.Clock()
+	// Day 0 of the following month is the last day of the target month.
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, date.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month+time.Month(months), day, hour, minute, second, date.Nanosecond(), date.Location())
 }
 
 // TimeDiffNow is a convenience method returning humanized time from now till date.
 func (humanizer *Humanizer) TimeDiffNow(date time.Time, precise bool) string {
-	return humanizer.TimeDiff(time.Now(), date, precise)
+	return humanizer.TimeDiff(humanizer.clock.Now(), date, precise)
+}
+
+// TimeDiffNowWith is a convenience method returning humanized time from now till date, using the given options.
+func (humanizer *Humanizer) TimeDiffNowWith(date time.Time, options DurationOptions) string {
+	return humanizer.TimeDiffWith(humanizer.clock.Now(), date, options)
 }
 
 // TimeDiff will return the humanized time difference between the given dates.
@@ -108,35 +436,208 @@ func (humanizer *Humanizer) TimeDiffNow(date time.Time, precise bool) string {
 //
 //	precise=false -> "3 months"
 //	precise=true  -> "2 months and 10 days"
+//
+// Differences shorter than a second are humanized in the sub-second units, e.g. "in 350 milliseconds". Longer ones
+// stop at seconds, so that e.g. "3 hours ago" does not end with nanoseconds. See TimeDiffWith for the other units.
 func (humanizer *Humanizer) TimeDiff(startDate, endDate time.Time, precise bool) string {
-	diff := endDate.Unix() - startDate.Unix()
-
-	// Don't bother with Math.Abs
-	absDiff := diff
-	if absDiff < 0 {
-		absDiff = -absDiff
+	options := DurationOptions{Precise: precise}
+	if diff := endDate.Sub(startDate); diff >= time.Second || diff <= -time.Second {
+		options.SmallestUnit = Second
 	}
+	return humanizer.TimeDiffWith(startDate, endDate, options)
+}
 
-	humanized := humanizer.humanizeDuration(absDiff, precise)
+// TimeDiffWith will return the humanized time difference between the given dates, using the given options.
+// In calendar mode months and years are counted on the calendar, e.g. from January 1st to January 1st of the next
+// year is "1 year", instead of "1 year and 5 days".
+func (humanizer *Humanizer) TimeDiffWith(startDate, endDate time.Time, options DurationOptions) string {
+	diff := endDate.Sub(startDate)
 
 	// Past or future?
-	if diff == 0 {
-		return humanized
-	} else if diff > 0 {
+	context := durationStandalone
+	if diff > 0 {
+		context = durationFuture
+	} else if diff < 0 {
+		context = durationPast
+	}
+
+	// Difference saturates at about 292 years, longer ones are counted in seconds.
+	saturated := diff == math.MaxInt64 || diff == math.MinInt64
+	// Don't bother with Math.Abs
+	if diff < 0 && !saturated {
+		diff = -diff
+	}
+	var parts []durationPart
+	qualifier := qualifierExact
+	switch {
+	case diff == 0:
+	case options.Calendar && options.Precise:
+		parts = humanizer.preciseCalendarDurationParts(startDate, endDate, options)
+	case options.Calendar && options.Approximation != nil:
+		var part durationPart
+		part, qualifier = humanizer.calendarApproximateDuration(startDate, endDate, *options.Approximation)
+		parts = []durationPart{part}
+	case options.Calendar:
+		parts = humanizer.calendarDurationParts(startDate, endDate, false)
+	case saturated:
+		seconds, nanoseconds := endDate.Unix()-startDate.Unix(), int64(endDate.Nanosecond()-startDate.Nanosecond())
+		if seconds < 0 {
+			seconds, nanoseconds = -seconds, -nanoseconds
+		}
+		if nanoseconds < 0 {
+			seconds, nanoseconds = seconds-1, nanoseconds+int64(time.Second)
+		}
+		parts, qualifier = humanizer.longDurationParts(seconds, nanoseconds, options)
+	default:
+		parts, qualifier = humanizer.limitedDurationParts(diff, options)
+	}
+	// Nothing is left of the difference, e.g. when it is shorter than SmallestUnit.
+	if isZeroDuration(parts) {
+		return humanizer.provider.times.now
+	}
+	humanized := humanizer.formatDurationParts(parts, qualifier, context, options.Style)
+
+	switch context {
+	case durationFuture:
 		return fmt.Sprintf(humanizer.provider.times.future, humanized)
-	} else {
+	case durationPast:
 		return fmt.Sprintf(humanizer.provider.times.past, humanized)
 	}
+	return humanized
+}
+
+// HumanizeDuration will return the humanized duration, e.g. "2 hours and 5 minutes". Precise durations can be
+// parsed back with ParseDuration. Calendar option is ignored, as there are no dates to count the months on.
+func (humanizer *Humanizer) HumanizeDuration(duration time.Duration, options DurationOptions) string {
+	sign := ""
+	if duration == math.MinInt64 {
+		// Negating it overflows, so it is counted in seconds.
+		seconds, nanoseconds := -int64(duration/time.Second), -int64(duration%time.Second)
+		parts, qualifier := humanizer.longDurationParts(seconds, nanoseconds, options)
+		return "-" + humanizer.formatDurationParts(parts, qualifier, durationStandalone, options.Style)
+	}
+	if duration < 0 {
+		sign = "-"
+		duration = -duration
+	}
+	if duration == 0 {
+		// Zero is expressed in seconds, unless a bigger unit is requested.
+		if options.SmallestUnit < Second {
+			options.SmallestUnit = Second
+		}
+		return humanizer.joinDurationParts(humanizer.limitDurationParts(nil, options), durationStandalone, options.Style)
+	}
+	parts, qualifier := humanizer.limitedDurationParts(duration, options)
+	return sign + humanizer.formatDurationParts(parts, qualifier, durationStandalone, options.Style)
 }
 
-// ParseDuration will return time duration as parsed from input string.
+// limitedDurationParts will split the positive duration into parts, using the fixed lengths of the units, limited and
+// rounded according to the options. Approximate durations have a single part, with its qualifier.
+func (humanizer *Humanizer) limitedDurationParts(duration time.Duration,
+	options DurationOptions) ([]durationPart, durationQualifier) {
+	ranges := humanizer.provider.times.ranges
+	switch {
+	case options.Precise:
+		return humanizer.preciseDurationParts(duration, options), qualifierExact
+	case options.Approximation != nil:
+		part, qualifier := humanizer.approximateDuration(duration, ranges, *options.Approximation)
+		return []durationPart{part}, qualifier
+	}
+	return humanizer.durationParts(duration, false, ranges), qualifierExact
+}
+
+// longDurationParts will split the positive duration of the given seconds and nanoseconds, exceeding time.Duration,
+// like limitedDurationParts. Whole units of the last range are counted aside, so that the rest fits in time.Duration.
+func (humanizer *Humanizer) longDurationParts(seconds, nanoseconds int64,
+	options DurationOptions) ([]durationPart, durationQualifier) {
+	last := len(humanizer.provider.times.ranges) - 1
+	unit := humanizer.provider.times.ranges[last].divideBy
+	unitSeconds := int64(unit / time.Second)
+	// One unit is left in the rest, so that the rest is limited and rounded with the whole units.
+	aside := seconds/unitSeconds - 1
+	rest := time.Duration(seconds%unitSeconds)*time.Second + time.Duration(nanoseconds) + unit
+	parts, qualifier := humanizer.limitedDurationParts(rest, options)
+	if len(parts) > 0 && parts[0].rangeIndex == last {
+		parts[0].count += aside
+		return parts, qualifier
+	}
+	return append([]durationPart{{last, aside}}, parts...), qualifier
+}
+
+// formatDurationParts will format the parts of a duration with the qualifier of an approximate one.
+func (humanizer *Humanizer) formatDurationParts(parts []durationPart, qualifier durationQualifier,
+	context durationContext, style Style) string {
+	humanized := humanizer.joinDurationParts(parts, context, style)
+	if format := humanizer.provider.times.qualifiers[qualifier]; format != "" {
+		humanized = fmt.Sprintf(format, humanized)
+	}
+	return humanized
+}
+
+// isZeroDuration checks whether all the parts have a zero count, e.g. when the duration is shorter than SmallestUnit.
+func isZeroDuration(parts []durationPart) bool {
+	for _, part := range parts {
+		if part.count != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseDuration will return time duration as parsed from input string. Durations in the ISO 8601 format, e.g.
+// "PT2H30M", are accepted as well, with the fixed lengths of the nominal units. Words in the Go syntax, e.g. "1h30m",
+// can be mixed with the localized input, e.g. "2 days 3h". Anything else in the input is ignored, see
+// ParseDurationStrict. Input in the language's past template, e.g. "3 hours ago", is negative. Leading sign, e.g.
+// "-3 hours", cannot be combined with the templates. Input without any number and unit found is read strictly, e.g.
+// "godzinę temu" in Polish, and its problems are reported as by ParseDurationStrict.
 func (humanizer *Humanizer) ParseDuration(input string) (time.Duration, error) {
-	allMatched := humanizer.timeInputRe.FindAllStringSubmatch(input, -1)
-	if len(allMatched) == 0 {
-		return time.Duration(0), fmt.Errorf("cannot parse %q", input)
+	if strings.TrimSpace(input) == "" {
+		return 0, &ParseError{Input: input, Err: ErrEmptyInput}
+	}
+	if duration, isISO, err := parseISODuration(input); isISO {
+		return duration, err
+	}
+	// Past template, e.g. "%s ago", makes the duration negative.
+	start, end, sign, err := humanizer.durationBounds(input)
+	if err != nil {
+		return 0, err
+	}
+	text := input[start:end]
+	totalDuration, rest, err := parseGoDurations(text)
+	if err != nil {
+		err.Input, err.Offset = input, start+err.Offset
+		return 0, err
+	}
+	// Units are matched regardless of the diacritics. Numbers written as words are replaced with digits first.
+	replaced := humanizer.replaceNumberWords(foldAccents(rest))
+	allMatched, matchedEnd := make([][]string, 0), 0
+	for _, location := range humanizer.timeInputRe.FindAllStringSubmatchIndex(replaced, -1) {
+		// Numbers have to start the word or follow the previous part, e.g. "1e3h" is not "3h", but "2d3h" is "2d" and
+		// "3h". Single letter units have to end the word, e.g. "3 many" is not "3 m".
+		previous, _ := utf8.DecodeLastRuneInString(replaced[:location[0]])
+		next, _ := utf8.DecodeRuneInString(replaced[location[1]:])
+		if location[0] != matchedEnd && (isSpacedLetter(previous) || unicode.IsDigit(previous) ||
+			previous == '-' || previous == '+') || !unitEnds(replaced[location[6]:location[7]], next) {
+			continue
+		}
+		matchedEnd = location[1]
+		matched := make([]string, len(location)/2)
+		for group := range matched {
+			matched[group] = replaced[location[2*group]:location[2*group+1]]
+		}
+		allMatched = append(allMatched, matched)
+	}
+	if len(allMatched) == 0 && rest == text {
+		// Nothing was found, so the input is read strictly, to report the problem as ParseDurationStrict does, e.g.
+		// ErrUnknownUnit for "bananas" in "5 bananas".
+		duration, err := newDurationScanner(humanizer, text).scan()
+		if err != nil {
+			err.Input, err.Offset = input, start+err.Offset
+			return 0, err
+		}
+		return sign * duration, nil
 	}
 
-	totalDuration := time.Duration(0)
 	for _, matched := range allMatched {
 		// 0 - full match, 1 - number, 2 - decimal, 3 - unit
 		if matched[2] == "" { // Decimal component is empty.
@@ -144,17 +645,59 @@ func (humanizer *Humanizer) ParseDuration(input string) (time.Duration, error) {
 		}
 		// Parse first two groups into a float. Can only fail if the regexp is wrong and allows non numbers.
 		number, _ := strconv.ParseFloat(matched[1]+"."+matched[2], 64)
-		// Get the value of the unit in seconds.
-		seconds, _ := humanizer.provider.times.units[matched[3]]
+		// Get the value of the unit.
+		unit, _ := humanizer.provider.times.units[strings.ToLower(matched[3])]
 		// Parser will simply sum up all the found durations.
-		totalDuration += time.Duration(int64(number * float64(seconds) * float64(time.Second)))
+		if number*float64(unit) >= float64(math.MaxInt64-totalDuration) {
+			return time.Duration(0), &ParseError{Input: input, Offset: start, Token: text, Err: ErrOverflow}
+		}
+		totalDuration += time.Duration(number * float64(unit))
 	}
 
-	if strings.HasPrefix(input, "-") {
-		totalDuration = -totalDuration
+	return sign * totalDuration, nil
+}
+
+// isUnitWord checks whether the whole word is an input time unit, possibly declined, e.g. "hours" but not "half".
+func (humanizer *Humanizer) isUnitWord(word string) bool {
+	for _, unit := range humanizer.timeUnits {
+		if len(word) < len(unit) || !strings.EqualFold(word[:len(unit)], unit) {
+			continue
+		}
+		if next, _ := utf8.DecodeRuneInString(word[len(unit):]); unitEnds(unit, next) {
+			return true
+		}
 	}
+	return false
+}
 
-	return totalDuration, nil
+// parseGoDurations will sum up the words of the input in the Go syntax, e.g. "1h30m" or "1.5h", and return the rest
+// of the input. Signs of the words are ignored, as the sign of the whole input is applied by ParseDuration. Sum too
+// long for a duration is reported at the offending word.
+func parseGoDurations(input string) (time.Duration, string, *ParseError) {
+	total, rest, offset := time.Duration(0), make([]string, 0), 0
+	for _, word := range strings.Fields(input) {
+		position := offset + strings.Index(input[offset:], word)
+		offset = position + len(word)
+		trimmed := strings.TrimRight(word, ",;")
+		// Go also accepts a bare zero, which is not a duration here.
+		duration, err := time.ParseDuration(trimmed)
+		if err != nil || !strings.ContainsFunc(trimmed, unicode.IsLetter) {
+			rest = append(rest, word)
+			continue
+		}
+		if duration < 0 {
+			duration = -duration
+		}
+		// Negating the shortest duration overflows as well.
+		if duration < 0 || duration > math.MaxInt64-total {
+			return 0, "", &ParseError{Offset: position, Token: trimmed, Err: ErrOverflow}
+		}
+		total += duration
+	}
+	if len(rest) == len(strings.Fields(input)) {
+		return 0, input, nil
+	}
+	return total, strings.Join(rest, " "), nil
 }
 
 // SecondsToTimeString converts the time in seconds into a human readable timestamp, eg.:
@@ -1,6 +1,8 @@
 package humanize
 
 import (
+	"math"
+	"strings"
 	"testing"
 	"time"
 )
@@ -16,6 +18,75 @@ func TestHumanizer_TimeDiffNow(t *testing.T) {
 			time.Duration(15 * 24 * time.Hour):         "in 2 weeks",
 			time.Duration(40 * 24 * time.Hour):         "in 1 month",
 		},
+		"de": {
+			time.Duration(0):                           "jetzt",
+			time.Duration(1 * time.Second):             "in 1 Sekunde",
+			time.Duration(15 * time.Minute):            "in 15 Minuten",
+			time.Duration(2*time.Hour + 5*time.Minute): "in 2 Stunden",
+			time.Duration(3 * 24 * time.Hour):          "in 3 Tagen",
+			time.Duration(15 * 24 * time.Hour):         "in 2 Wochen",
+			time.Duration(40 * 24 * time.Hour):         "in 1 Monat",
+		},
+		"ru": {
+			time.Duration(0):                           "сейчас",
+			time.Duration(1 * time.Second):             "через 1 секунду",
+			time.Duration(15 * time.Minute):            "через 15 минут",
+			time.Duration(2*time.Hour + 5*time.Minute): "через 2 часа",
+			time.Duration(3 * 24 * time.Hour):          "через 3 дня",
+			time.Duration(15 * 24 * time.Hour):         "через 2 недели",
+			time.Duration(40 * 24 * time.Hour):         "через 1 месяц",
+		},
+		"uk": {
+			time.Duration(0):                           "зараз",
+			time.Duration(1 * time.Second):             "через 1 секунду",
+			time.Duration(15 * time.Minute):            "через 15 хвилин",
+			time.Duration(2*time.Hour + 5*time.Minute): "через 2 години",
+			time.Duration(3 * 24 * time.Hour):          "через 3 дні",
+			time.Duration(15 * 24 * time.Hour):         "через 2 тижні",
+			time.Duration(40 * 24 * time.Hour):         "через 1 місяць",
+		},
+		"fr": {
+			time.Duration(0):                   "maintenant",
+			time.Duration(1 * time.Second):     "dans 1 seconde",
+			time.Duration(15 * time.Minute):    "dans 15 minutes",
+			time.Duration(3 * 24 * time.Hour):  "dans 3 jours",
+			time.Duration(40 * 24 * time.Hour): "dans 1 mois",
+		},
+		"es": {
+			time.Duration(0):                   "ahora",
+			time.Duration(1 * time.Second):     "dentro de 1 segundo",
+			time.Duration(15 * time.Minute):    "dentro de 15 minutos",
+			time.Duration(3 * 24 * time.Hour):  "dentro de 3 días",
+			time.Duration(40 * 24 * time.Hour): "dentro de 1 mes",
+		},
+		"it": {
+			time.Duration(0):                   "adesso",
+			time.Duration(1 * time.Second):     "tra 1 secondo",
+			time.Duration(15 * time.Minute):    "tra 15 minuti",
+			time.Duration(3 * 24 * time.Hour):  "tra 3 giorni",
+			time.Duration(40 * 24 * time.Hour): "tra 1 mese",
+		},
+		"ja": {
+			time.Duration(0):                   "今",
+			time.Duration(1 * time.Second):     "1秒後",
+			time.Duration(15 * time.Minute):    "15分後",
+			time.Duration(3 * 24 * time.Hour):  "3日後",
+			time.Duration(40 * 24 * time.Hour): "1か月後",
+		},
+		"zh": {
+			time.Duration(0):                   "现在",
+			time.Duration(1 * time.Second):     "1秒后",
+			time.Duration(15 * time.Minute):    "15分钟后",
+			time.Duration(3 * 24 * time.Hour):  "3天后",
+			time.Duration(40 * 24 * time.Hour): "1个月后",
+		},
+		"ko": {
+			time.Duration(0):                   "지금",
+			time.Duration(1 * time.Second):     "1초 후",
+			time.Duration(15 * time.Minute):    "15분 후",
+			time.Duration(3 * 24 * time.Hour):  "3일 후",
+			time.Duration(40 * 24 * time.Hour): "1개월 후",
+		},
 		"pl": {
 			time.Duration(0):                           "teraz",
 			time.Duration(1 * time.Second):             "za sekundę",
@@ -27,14 +98,15 @@ func TestHumanizer_TimeDiffNow(t *testing.T) {
 		},
 	}
 
+	clock := NewFakeClock(time.Date(2017, 3, 15, 10, 0, 0, 300, time.UTC))
 	for lang, caseList := range cases {
-		humanizer, err := New(lang)
+		humanizer, err := New(lang, WithClock(clock))
 		if err != nil {
 			t.Errorf("Humanizer creation failed with error: %s", err)
 		}
 
 		for duration, expected := range caseList {
-			humanized := humanizer.TimeDiffNow(time.Now().Add(duration), false)
+			humanized := humanizer.TimeDiffNow(clock.Now().Add(duration), false)
 			if humanized != expected {
 				t.Errorf("Expected '%s', got '%s'.", expected, humanized)
 			}
@@ -64,13 +136,14 @@ func TestHumanizer_TimeDiff_PreciseSkip(t *testing.T) {
 }
 
 func TestHumanizer_TimeDiffNow_TZ(t *testing.T) {
-	humanizer, err := New("en")
+	clock := NewFakeClock(time.Date(2017, 3, 15, 10, 0, 0, 0, time.UTC))
+	humanizer, err := New("en", WithClock(clock))
 	if err != nil {
 		t.Errorf("Humanizer creation failed with error: %s", err)
 	}
 	// Set arbitrary location.
 	loc, _ := time.LoadLocation("Asia/Shanghai")
-	date := time.Now().Add(time.Duration(5 * time.Minute)).In(loc)
+	date := clock.Now().Add(time.Duration(5 * time.Minute)).In(loc)
 	// Make sure that TimeDiffNow is TZ agnostic.
 	humanized := humanizer.TimeDiffNow(date, false)
 	if humanized != "in 5 minutes" {
@@ -94,6 +167,94 @@ func TestHumanizer_TimeDiff_Imprecise(t *testing.T) {
 			time.Date(2000, 7, 15, 12, 0, 1, 0, time.UTC):   "in 1 month",
 			time.Date(2000, 5, 15, 12, 0, 1, 0, time.UTC):   "1 month ago",
 		},
+		"de": {
+			time.Date(2000, 6, 15, 12, 0, 0, 0, time.UTC):   "jetzt",
+			time.Date(2000, 6, 15, 12, 0, 1, 0, time.UTC):   "in 1 Sekunde",
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "vor 30 Sekunden",
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC):  "in 15 Minuten",
+			time.Date(2000, 6, 15, 11, 49, 1, 0, time.UTC):  "vor 10 Minuten",
+			time.Date(2000, 6, 18, 12, 0, 1, 0, time.UTC):   "in 3 Tagen",
+			time.Date(2000, 6, 10, 5, 0, 1, 0, time.UTC):    "vor 5 Tagen",
+			time.Date(2000, 6, 29, 12, 0, 1, 0, time.UTC):   "in 2 Wochen",
+			time.Date(2000, 6, 1, 1, 0, 1, 0, time.UTC):     "vor 2 Wochen",
+			time.Date(2000, 7, 15, 12, 0, 1, 0, time.UTC):   "in 1 Monat",
+			time.Date(2000, 5, 15, 12, 0, 1, 0, time.UTC):   "vor 1 Monat",
+			time.Date(2003, 5, 15, 12, 0, 1, 0, time.UTC):   "in 2 Jahren",
+		},
+		"ru": {
+			time.Date(2000, 6, 15, 12, 0, 0, 0, time.UTC):   "сейчас",
+			time.Date(2000, 6, 15, 12, 0, 1, 0, time.UTC):   "через 1 секунду",
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "30 секунд назад",
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC):  "через 15 минут",
+			time.Date(2000, 6, 15, 11, 37, 1, 0, time.UTC):  "22 минуты назад",
+			time.Date(2000, 6, 15, 11, 38, 1, 0, time.UTC):  "21 минуту назад",
+			time.Date(2000, 6, 15, 11, 48, 1, 0, time.UTC):  "11 минут назад",
+			time.Date(2000, 6, 18, 12, 0, 1, 0, time.UTC):   "через 3 дня",
+			time.Date(2000, 6, 10, 5, 0, 1, 0, time.UTC):    "5 дней назад",
+			time.Date(2000, 6, 29, 12, 0, 1, 0, time.UTC):   "через 2 недели",
+			time.Date(2000, 6, 1, 1, 0, 1, 0, time.UTC):     "2 недели назад",
+			time.Date(2000, 7, 15, 12, 0, 1, 0, time.UTC):   "через 1 месяц",
+			time.Date(2000, 5, 15, 12, 0, 1, 0, time.UTC):   "1 месяц назад",
+			time.Date(2005, 7, 15, 12, 0, 1, 0, time.UTC):   "через 5 лет",
+		},
+		"uk": {
+			time.Date(2000, 6, 15, 12, 0, 0, 0, time.UTC):   "зараз",
+			time.Date(2000, 6, 15, 12, 0, 1, 0, time.UTC):   "через 1 секунду",
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "30 секунд тому",
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC):  "через 15 хвилин",
+			time.Date(2000, 6, 15, 11, 37, 1, 0, time.UTC):  "22 хвилини тому",
+			time.Date(2000, 6, 15, 11, 38, 1, 0, time.UTC):  "21 хвилину тому",
+			time.Date(2000, 6, 15, 11, 48, 1, 0, time.UTC):  "11 хвилин тому",
+			time.Date(2000, 6, 18, 12, 0, 1, 0, time.UTC):   "через 3 дні",
+			time.Date(2000, 6, 10, 5, 0, 1, 0, time.UTC):    "5 днів тому",
+			time.Date(2000, 6, 29, 12, 0, 1, 0, time.UTC):   "через 2 тижні",
+			time.Date(2000, 6, 1, 1, 0, 1, 0, time.UTC):     "2 тижні тому",
+			time.Date(2000, 7, 15, 12, 0, 1, 0, time.UTC):   "через 1 місяць",
+			time.Date(2000, 5, 15, 12, 0, 1, 0, time.UTC):   "1 місяць тому",
+			time.Date(2005, 7, 15, 12, 0, 1, 0, time.UTC):   "через 5 років",
+		},
+		"fr": {
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "il y a 30 secondes",
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC):  "dans 15 minutes",
+			time.Date(2000, 6, 10, 5, 0, 1, 0, time.UTC):    "il y a 5 jours",
+			time.Date(2000, 6, 1, 1, 0, 1, 0, time.UTC):     "il y a 2 semaines",
+			time.Date(2000, 5, 15, 12, 0, 1, 0, time.UTC):   "il y a 1 mois",
+			time.Date(2003, 5, 15, 12, 0, 1, 0, time.UTC):   "dans 2 ans",
+		},
+		"es": {
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "hace 30 segundos",
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC):  "dentro de 15 minutos",
+			time.Date(2000, 6, 10, 5, 0, 1, 0, time.UTC):    "hace 5 días",
+			time.Date(2000, 6, 1, 1, 0, 1, 0, time.UTC):     "hace 2 semanas",
+			time.Date(2000, 5, 15, 12, 0, 1, 0, time.UTC):   "hace 1 mes",
+			time.Date(2003, 5, 15, 12, 0, 1, 0, time.UTC):   "dentro de 2 años",
+		},
+		"it": {
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "30 secondi fa",
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC):  "tra 15 minuti",
+			time.Date(2000, 6, 10, 5, 0, 1, 0, time.UTC):    "5 giorni fa",
+			time.Date(2000, 6, 1, 1, 0, 1, 0, time.UTC):     "2 settimane fa",
+			time.Date(2000, 5, 15, 12, 0, 1, 0, time.UTC):   "1 mese fa",
+			time.Date(2003, 5, 15, 12, 0, 1, 0, time.UTC):   "tra 2 anni",
+		},
+		"ja": {
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "30秒前",
+			time.Date(2000, 6, 10, 5, 0, 1, 0, time.UTC):    "5日前",
+			time.Date(2000, 6, 1, 1, 0, 1, 0, time.UTC):     "2週間前",
+			time.Date(2003, 5, 15, 12, 0, 1, 0, time.UTC):   "2年後",
+		},
+		"zh": {
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "30秒前",
+			time.Date(2000, 6, 10, 5, 0, 1, 0, time.UTC):    "5天前",
+			time.Date(2000, 6, 1, 1, 0, 1, 0, time.UTC):     "2周前",
+			time.Date(2003, 5, 15, 12, 0, 1, 0, time.UTC):   "2年后",
+		},
+		"ko": {
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "30초 전",
+			time.Date(2000, 6, 10, 5, 0, 1, 0, time.UTC):    "5일 전",
+			time.Date(2000, 6, 1, 1, 0, 1, 0, time.UTC):     "2주 전",
+			time.Date(2003, 5, 15, 12, 0, 1, 0, time.UTC):   "2년 후",
+		},
 		"pl": {
 			time.Date(2000, 6, 15, 12, 0, 0, 0, time.UTC):   "teraz",
 			time.Date(2000, 6, 15, 12, 0, 1, 0, time.UTC):   "za sekundę",
@@ -137,6 +298,63 @@ func TestHumanizer_TimeDiff_Precise(t *testing.T) {
 			time.Date(2000, 6, 10, 5, 0, 0, 0, time.UTC):    "5 days and 7 hours ago",
 			time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC):     "in 20 years, 5 months, 1 day and 12 hours",
 		},
+		"de": {
+			time.Date(2000, 6, 15, 12, 0, 1, 0, time.UTC):   "in 1 Sekunde",
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "vor 30 Sekunden",
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC):  "in 15 Minuten und 1 Sekunde",
+			time.Date(2000, 6, 15, 11, 49, 1, 0, time.UTC):  "vor 10 Minuten und 59 Sekunden",
+			time.Date(2000, 6, 18, 12, 5, 1, 0, time.UTC):   "in 3 Tagen, 5 Minuten und 1 Sekunde",
+			time.Date(2000, 6, 10, 5, 0, 0, 0, time.UTC):    "vor 5 Tagen und 7 Stunden",
+			time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC):     "in 20 Jahren, 5 Monaten, 1 Tag und 12 Stunden",
+		},
+		"ru": {
+			time.Date(2000, 6, 15, 12, 0, 1, 0, time.UTC):   "через 1 секунду",
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "30 секунд назад",
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC):  "через 15 минут и 1 секунду",
+			time.Date(2000, 6, 15, 11, 49, 1, 0, time.UTC):  "10 минут и 59 секунд назад",
+			time.Date(2000, 6, 18, 12, 5, 1, 0, time.UTC):   "через 3 дня, 5 минут и 1 секунду",
+			time.Date(2000, 6, 10, 5, 0, 0, 0, time.UTC):    "5 дней и 7 часов назад",
+			time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC):     "через 20 лет, 5 месяцев, 1 день и 12 часов",
+		},
+		"uk": {
+			time.Date(2000, 6, 15, 12, 0, 1, 0, time.UTC):   "через 1 секунду",
+			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "30 секунд тому",
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC):  "через 15 хвилин і 1 секунду",
+			time.Date(2000, 6, 15, 11, 49, 1, 0, time.UTC):  "10 хвилин і 59 секунд тому",
+			time.Date(2000, 6, 18, 12, 5, 1, 0, time.UTC):   "через 3 дні, 5 хвилин і 1 секунду",
+			time.Date(2000, 6, 10, 5, 0, 0, 0, time.UTC):    "5 днів і 7 годин тому",
+			time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC):     "через 20 років, 5 місяців, 1 день і 12 годин",
+		},
+		"fr": {
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC): "dans 15 minutes et 1 seconde",
+			time.Date(2000, 6, 10, 5, 0, 0, 0, time.UTC):   "il y a 5 jours et 7 heures",
+			time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC):    "dans 20 ans, 5 mois, 1 jour et 12 heures",
+		},
+		"es": {
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC): "dentro de 15 minutos y 1 segundo",
+			time.Date(2000, 6, 10, 5, 0, 0, 0, time.UTC):   "hace 5 días y 7 horas",
+			time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC):    "dentro de 20 años, 5 meses, 1 día y 12 horas",
+		},
+		"it": {
+			time.Date(2000, 6, 15, 12, 15, 1, 0, time.UTC): "tra 15 minuti e 1 secondo",
+			time.Date(2000, 6, 10, 5, 0, 0, 0, time.UTC):   "5 giorni e 7 ore fa",
+			time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC):    "tra 20 anni, 5 mesi, 1 giorno e 12 ore",
+		},
+		"ja": {
+			time.Date(2000, 6, 18, 12, 5, 1, 0, time.UTC): "3日、5分と1秒後",
+			time.Date(2000, 6, 10, 5, 0, 0, 0, time.UTC):  "5日と7時間前",
+			time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC):   "20年、5か月、1日と12時間後",
+		},
+		"zh": {
+			time.Date(2000, 6, 18, 12, 5, 1, 0, time.UTC): "3天5分钟1秒后",
+			time.Date(2000, 6, 10, 5, 0, 0, 0, time.UTC):  "5天7小时前",
+			time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC):   "20年5个月1天12小时后",
+		},
+		"ko": {
+			time.Date(2000, 6, 18, 12, 5, 1, 0, time.UTC): "3일 5분 1초 후",
+			time.Date(2000, 6, 10, 5, 0, 0, 0, time.UTC):  "5일 7시간 전",
+			time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC):   "20년 5개월 1일 12시간 후",
+		},
 		"pl": {
 			time.Date(2000, 6, 15, 12, 0, 1, 0, time.UTC):   "za sekundę",
 			time.Date(2000, 6, 15, 11, 59, 30, 0, time.UTC): "30 sekund temu",
@@ -163,6 +381,454 @@ func TestHumanizer_TimeDiff_Precise(t *testing.T) {
 	}
 }
 
+func TestHumanizer_TimeDiff_Calendar(t *testing.T) {
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	warsaw, err := time.LoadLocation("Europe/Warsaw")
+	if err != nil {
+		t.Fatalf("Loading location failed with error: %s", err)
+	}
+
+	cases := []struct {
+		startDate, endDate time.Time
+		precise            bool
+		expected           string
+	}{
+		{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), true, "in 1 year"},
+		{time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), false, "1 year ago"},
+		{time.Date(2017, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2017, 2, 28, 0, 0, 0, 0, time.UTC), true, "in 1 month"},
+		{time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), true, "in 1 month and 1 day"},
+		{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2017, 1, 31, 0, 0, 0, 0, time.UTC), true, "in 30 days"},
+		{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2017, 1, 31, 0, 0, 0, 0, time.UTC), false, "in 4 weeks"},
+		{time.Date(2020, 3, 15, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 10, 9, 0, 0, 0, time.UTC), true,
+			"1 year, 2 months, 5 days and 1 hour ago"},
+		{time.Date(2017, 3, 21, 12, 30, 15, 0, time.UTC), time.Date(2017, 6, 21, 0, 0, 0, 0, time.UTC), true,
+			"in 2 months, 30 days, 11 hours, 29 minutes and 45 seconds"},
+		{time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), false, "in 100 years"},
+		{time.Date(2000, 6, 15, 12, 0, 0, 0, time.UTC), time.Date(2000, 6, 15, 12, 0, 0, 500, time.UTC), true, "in 500 nanoseconds"},
+		// Day with the daylight saving time change has 23 hours.
+		{time.Date(2021, 3, 27, 12, 0, 0, 0, warsaw), time.Date(2021, 3, 28, 12, 0, 0, 0, warsaw), true, "in 1 day"},
+		// Dates are compared in the location of the start date.
+		{time.Date(2021, 2, 28, 23, 0, 0, 0, warsaw), time.Date(2021, 3, 28, 21, 0, 0, 0, time.UTC), true, "in 1 month"},
+	}
+
+	for _, tc := range cases {
+		humanized := humanizer.TimeDiffWith(tc.startDate, tc.endDate, DurationOptions{Precise: tc.precise, Calendar: true})
+		if humanized != tc.expected {
+			t.Errorf("Expected '%s', got '%s'.", tc.expected, humanized)
+		}
+	}
+
+	// Without the calendar mode, fixed lengths of months and years are used.
+	humanized := humanizer.TimeDiff(
+		time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), true)
+	if humanized != "in 1 year and 5 days" {
+		t.Errorf("Expected 'in 1 year and 5 days', got '%s'.", humanized)
+	}
+}
+
+func TestHumanizer_TimeDiff_Approximation(t *testing.T) {
+	startDate := time.Date(2000, 1, 15, 12, 0, 0, 0, time.UTC)
+	cases := map[string]map[time.Time]string{
+		"en": {
+			startDate: "now",
+			time.Date(2000, 1, 15, 12, 0, 40, 0, time.UTC): "in 40 seconds",
+			time.Date(2000, 1, 15, 13, 0, 0, 0, time.UTC):  "in 1 hour",
+			time.Date(2000, 1, 15, 13, 5, 0, 0, time.UTC):  "in about 1 hour",
+			time.Date(2000, 1, 15, 14, 30, 0, 0, time.UTC): "in over 2 hours",
+			time.Date(2000, 1, 15, 11, 0, 10, 0, time.UTC): "almost 1 hour ago",
+			time.Date(2000, 1, 16, 11, 50, 0, 0, time.UTC): "in almost 1 day",
+			time.Date(2001, 1, 10, 12, 0, 0, 0, time.UTC):  "in almost 1 year",
+			time.Date(2001, 12, 15, 12, 0, 0, 0, time.UTC): "in almost 2 years",
+			time.Date(1998, 10, 1, 12, 0, 0, 0, time.UTC):  "over 1 year ago",
+			time.Date(2002, 2, 1, 12, 0, 0, 0, time.UTC):   "in about 2 years",
+		},
+		"pl": {
+			time.Date(2000, 1, 15, 13, 5, 0, 0, time.UTC):  "za mniej więcej godzinę",
+			time.Date(2000, 1, 15, 11, 0, 10, 0, time.UTC): "prawie godzinę temu",
+			time.Date(2001, 12, 15, 12, 0, 0, 0, time.UTC): "za prawie 2 lata",
+			time.Date(1998, 10, 1, 12, 0, 0, 0, time.UTC):  "ponad rok temu",
+			time.Date(2000, 1, 20, 12, 0, 0, 0, time.UTC):  "za 5 dni",
+		},
+		// Languages without qualifiers are only rounded.
+		"de": {
+			time.Date(2001, 12, 15, 12, 0, 0, 0, time.UTC): "in 2 Jahren",
+			time.Date(1998, 10, 1, 12, 0, 0, 0, time.UTC):  "vor 1 Jahr",
+		},
+	}
+	options := DurationOptions{Calendar: true, Approximation: &DefaultApproximation}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for endDate, expected := range caseList {
+			humanized := humanizer.TimeDiffWith(startDate, endDate, options)
+			if humanized != expected {
+				t.Errorf("Expected '%s', got '%s'.", expected, humanized)
+			}
+		}
+	}
+
+	// Fixed lengths of months and years are used without the calendar mode.
+	humanizer, _ := New("en")
+	options.Calendar = false
+	humanized := humanizer.TimeDiffWith(startDate, time.Date(2001, 1, 14, 12, 0, 0, 0, time.UTC), options)
+	if humanized != "in about 1 year" {
+		t.Errorf("Expected 'in about 1 year', got '%s'.", humanized)
+	}
+	humanizer, _ = New("en", WithClock(NewFakeClock(startDate)))
+	humanized = humanizer.TimeDiffNowWith(startDate.Add(-(11*Month+25*Day)*time.Second), options)
+	if humanized != "almost 1 year ago" {
+		t.Errorf("Expected 'almost 1 year ago', got '%s'.", humanized)
+	}
+}
+
+func TestApproximation_Clamped(t *testing.T) {
+	cases := map[Approximation]Approximation{
+		DefaultApproximation:                   DefaultApproximation,
+		{Over: -1, Almost: 2}:                  {Over: 0, Almost: 1},
+		{Over: 0.8, Almost: 0.2}:               {Over: 0.8, Almost: 0.8},
+		{Over: math.NaN(), Almost: math.NaN()}: DefaultApproximation,
+		{Over: 0.5, Almost: math.Inf(-1)}:      {Over: 0.5, Almost: 0.5},
+	}
+	for approximation, expected := range cases {
+		if clamped := approximation.clamped(); clamped != expected {
+			t.Errorf("Expected '%+v', got '%+v'.", expected, clamped)
+		}
+	}
+
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	startDate := time.Date(2000, 1, 15, 12, 0, 0, 0, time.UTC)
+	endDate := startDate.Add(90 * time.Minute)
+	humanizedCases := []struct {
+		approximation Approximation
+		expected      string
+	}{
+		{Approximation{Over: -1, Almost: 0.9}, "in over 1 hour"},
+		{Approximation{Over: 0.1, Almost: -1}, "in almost 2 hours"},
+		{Approximation{Over: math.NaN(), Almost: math.NaN()}, "in over 1 hour"},
+		{Approximation{Over: 0.75, Almost: 0.25}, "in about 1 hour"},
+	}
+	for _, testCase := range humanizedCases {
+		options := DurationOptions{Approximation: &testCase.approximation}
+		if humanized := humanizer.TimeDiffWith(startDate, endDate, options); humanized != testCase.expected {
+			t.Errorf("Expected '%s', got '%s'.", testCase.expected, humanized)
+		}
+	}
+}
+
+func TestHumanizer_TimeDiff_MaxParts(t *testing.T) {
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	startDate := time.Date(2017, 6, 21, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2017, 3, 21, 12, 30, 15, 0, time.UTC)
+
+	cases := []struct {
+		options  DurationOptions
+		expected string
+	}{
+		{DurationOptions{Precise: true}, "3 months, 1 day, 11 hours, 29 minutes and 45 seconds ago"},
+		{DurationOptions{Precise: true, MaxParts: 2}, "3 months and 1 day ago"},
+		{DurationOptions{Precise: true, MaxParts: 3, Rounding: RoundHalfUp}, "3 months, 1 day and 11 hours ago"},
+		{DurationOptions{Precise: true, MaxParts: 3, Rounding: RoundUp}, "3 months, 1 day and 12 hours ago"},
+		{DurationOptions{Precise: true, SmallestUnit: Minute}, "3 months, 1 day, 11 hours and 29 minutes ago"},
+		{DurationOptions{Precise: true, SmallestUnit: Minute, Rounding: RoundHalfUp},
+			"3 months, 1 day, 11 hours and 30 minutes ago"},
+		{DurationOptions{Precise: true, SmallestUnit: Day, Rounding: RoundHalfUp}, "3 months and 1 day ago"},
+		// Calendar mode.
+		{DurationOptions{Precise: true, Calendar: true, MaxParts: 2}, "2 months and 30 days ago"},
+		{DurationOptions{Precise: true, Calendar: true, MaxParts: 2, Rounding: RoundUp}, "3 months ago"},
+		{DurationOptions{Precise: true, Calendar: true, SmallestUnit: Month, Rounding: RoundHalfUp}, "3 months ago"},
+		{DurationOptions{Precise: true, Calendar: true, SmallestUnit: Year}, "now"},
+		{DurationOptions{Precise: true, Calendar: true, SmallestUnit: Year, Rounding: RoundUp}, "1 year ago"},
+	}
+
+	for _, tc := range cases {
+		humanized := humanizer.TimeDiffWith(startDate, endDate, tc.options)
+		if humanized != tc.expected {
+			t.Errorf("%+v: expected '%s', got '%s'.", tc.options, tc.expected, humanized)
+		}
+	}
+
+	// Rounding carries over to bigger units.
+	options := DurationOptions{Precise: true, MaxParts: 2, Rounding: RoundHalfUp}
+	humanized := humanizer.TimeDiffWith(startDate, startDate.Add(time.Hour+59*time.Minute+40*time.Second), options)
+	if humanized != "in 2 hours" {
+		t.Errorf("Expected 'in 2 hours', got '%s'.", humanized)
+	}
+	options = DurationOptions{Precise: true, SmallestUnit: Minute, Rounding: RoundHalfUp}
+	if humanized = humanizer.TimeDiffWith(startDate, startDate.Add(20*time.Second), options); humanized != "now" {
+		t.Errorf("Expected 'now', got '%s'.", humanized)
+	}
+
+	// Weeks are skipped in the precise mode, unless they are the smallest unit.
+	weeks := map[time.Duration]map[Rounding]string{
+		15 * Day * time.Second: {RoundDown: "in 2 weeks", RoundUp: "in 3 weeks"},
+		40 * Day * time.Second: {RoundDown: "in 1 month and 1 week", RoundUp: "in 1 month and 2 weeks"},
+		3 * time.Hour:          {RoundDown: "now", RoundUp: "in 1 week"},
+		27 * Day * time.Second: {RoundHalfUp: "in 4 weeks"},
+		29 * Day * time.Second: {RoundUp: "in 1 month"},
+	}
+	for duration, roundings := range weeks {
+		for rounding, expected := range roundings {
+			options = DurationOptions{Precise: true, SmallestUnit: Week, Rounding: rounding}
+			if humanized = humanizer.TimeDiffWith(startDate, startDate.Add(duration), options); humanized != expected {
+				t.Errorf("Expected '%s', got '%s'.", expected, humanized)
+			}
+		}
+	}
+	options = DurationOptions{Precise: true, Calendar: true, SmallestUnit: Week}
+	if humanized = humanizer.TimeDiffWith(startDate, startDate.AddDate(0, 1, 16), options); humanized != "in 1 month and 2 weeks" {
+		t.Errorf("Expected 'in 1 month and 2 weeks', got '%s'.", humanized)
+	}
+}
+
+func TestHumanizer_TimeDiff_SubSecond(t *testing.T) {
+	startDate := time.Date(2000, 6, 15, 12, 0, 0, 0, time.UTC)
+	cases := map[string]map[time.Duration]string{
+		"en": {
+			350 * time.Millisecond:             "in 350 milliseconds",
+			-1500 * time.Microsecond:           "1 millisecond and 500 microseconds ago",
+			2 * time.Nanosecond:                "in 2 nanoseconds",
+			time.Second + 500*time.Millisecond: "in 1 second and 500 milliseconds",
+		},
+		"pl": {
+			-350 * time.Millisecond:            "350 milisekund temu",
+			22 * time.Microsecond:              "za 22 mikrosekundy",
+			time.Millisecond + time.Nanosecond: "za milisekundę i nanosekundę",
+		},
+	}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for diff, expected := range caseList {
+			humanized := humanizer.TimeDiffWith(startDate, startDate.Add(diff), DurationOptions{Precise: true})
+			if humanized != expected {
+				t.Errorf("Expected '%s', got '%s'.", expected, humanized)
+			}
+		}
+	}
+
+	humanizer, _ := New("en")
+	humanized := humanizer.TimeDiff(startDate, startDate.Add(350*time.Millisecond), true)
+	if humanized != "in 350 milliseconds" {
+		t.Errorf("Expected 'in 350 milliseconds', got '%s'.", humanized)
+	}
+	if humanized = humanizer.TimeDiff(startDate, startDate.Add(-1200*time.Millisecond), false); humanized != "1 second ago" {
+		t.Errorf("Expected '1 second ago', got '%s'.", humanized)
+	}
+	// Sub-second units are only used for the differences shorter than a second.
+	humanized = humanizer.TimeDiff(startDate, startDate.Add(2*time.Hour+123456789), true)
+	if humanized != "in 2 hours" {
+		t.Errorf("Expected 'in 2 hours', got '%s'.", humanized)
+	}
+	humanized = humanizer.TimeDiff(startDate, startDate.Add(-3*time.Hour-time.Second-549), true)
+	if humanized != "3 hours and 1 second ago" {
+		t.Errorf("Expected '3 hours and 1 second ago', got '%s'.", humanized)
+	}
+	options := DurationOptions{Precise: true, SmallestUnit: Millisecond, Rounding: RoundHalfUp}
+	humanized = humanizer.TimeDiffWith(startDate, startDate.Add(2500600*time.Nanosecond), options)
+	if humanized != "in 3 milliseconds" {
+		t.Errorf("Expected 'in 3 milliseconds', got '%s'.", humanized)
+	}
+}
+
+func TestHumanizer_HumanizeDuration(t *testing.T) {
+	cases := map[string]map[string]struct {
+		duration time.Duration
+		options  DurationOptions
+	}{
+		"en": {
+			"0 seconds":                   {0, DurationOptions{Precise: true}},
+			"0 minutes":                   {0, DurationOptions{SmallestUnit: Minute}},
+			"0 weeks":                     {3 * time.Hour, DurationOptions{Precise: true, SmallestUnit: Week}},
+			"2 weeks":                     {15 * Day * time.Second, DurationOptions{Precise: true, SmallestUnit: Week}},
+			"2 hours":                     {2*time.Hour + 5*time.Minute, DurationOptions{}},
+			"2 hours and 5 minutes":       {2*time.Hour + 5*time.Minute, DurationOptions{Precise: true}},
+			"-1 minute and 30 seconds":    {-90 * time.Second, DurationOptions{Precise: true}},
+			"about 2 hours":               {2*time.Hour + 5*time.Minute, DurationOptions{Approximation: &DefaultApproximation}},
+			"1 day and 3 hours":           {27*time.Hour + 5*time.Minute, DurationOptions{Precise: true, MaxParts: 2}},
+			"350 milliseconds":            {350 * time.Millisecond, DurationOptions{Precise: true}},
+			"1 second":                    {1400 * time.Millisecond, DurationOptions{Precise: true, SmallestUnit: Second}},
+			"1 year, 1 month and 10 days": {400 * Day * time.Second, DurationOptions{Precise: true}},
+		},
+		"pl": {
+			"1 sekunda":            {time.Second, DurationOptions{Precise: true}},
+			"1 godzina i 1 minuta": {time.Hour + time.Minute, DurationOptions{Precise: true}},
+			"prawie 2 lata":        {700 * Day * time.Second, DurationOptions{Approximation: &DefaultApproximation}},
+		},
+		// Undefined style falls back to the long one.
+		"de": {
+			"1 Jahr und 1 Nanosekunde": {Year*time.Second + 1, DurationOptions{Precise: true, Style: StyleNarrow}},
+		},
+	}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for expected, tc := range caseList {
+			if humanized := humanizer.HumanizeDuration(tc.duration, tc.options); humanized != expected {
+				t.Errorf("Expected '%s', got '%s'.", expected, humanized)
+			}
+		}
+	}
+}
+
+func TestHumanizer_HumanizeDuration_Extremes(t *testing.T) {
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	precise := "296 years, 6 months, 11 days, 23 hours, 47 minutes, 16 seconds, 854 milliseconds, 775 microseconds and "
+	cases := []struct {
+		duration time.Duration
+		options  DurationOptions
+		expected string
+	}{
+		{math.MaxInt64, DurationOptions{Precise: true}, precise + "807 nanoseconds"},
+		{math.MinInt64, DurationOptions{Precise: true}, "-" + precise + "808 nanoseconds"},
+		{math.MinInt64, DurationOptions{}, "-296 years"},
+		{math.MaxInt64, DurationOptions{Precise: true, MaxParts: 2, Rounding: RoundUp}, "296 years and 7 months"},
+		{math.MinInt64, DurationOptions{Precise: true, MaxParts: 2, Rounding: RoundUp}, "-296 years and 7 months"},
+		{math.MaxInt64, DurationOptions{Precise: true, SmallestUnit: Year, Rounding: RoundHalfUp}, "297 years"},
+	}
+	for _, testCase := range cases {
+		if humanized := humanizer.HumanizeDuration(testCase.duration, testCase.options); humanized != testCase.expected {
+			t.Errorf("Expected '%s', got '%s'.", testCase.expected, humanized)
+		}
+	}
+}
+
+func TestHumanizer_HumanizeDuration_Style(t *testing.T) {
+	duration := 3*time.Hour + 5*time.Minute
+	long := 400*Day*time.Second + 3*time.Hour + 250*time.Millisecond
+	cases := map[string]map[string]string{
+		"en": {
+			"3 hr, 5 min":                        humanize(t, "en", duration, StyleShort),
+			"3h 5m":                              humanize(t, "en", duration, StyleNarrow),
+			"1 yr, 1 mth, 10 days, 3 hr, 250 ms": humanize(t, "en", long, StyleShort),
+			"1y 1mo 10d 3h 250ms":                humanize(t, "en", long, StyleNarrow),
+		},
+		"pl": {
+			"3 godz. 5 min":                       humanize(t, "pl", duration, StyleShort),
+			"3 g. 5 min":                          humanize(t, "pl", duration, StyleNarrow),
+			"1 rok 1 mies. 10 dni 3 godz. 250 ms": humanize(t, "pl", long, StyleShort),
+			"1 r. 1 mies. 10 d. 3 g. 250 ms":      humanize(t, "pl", long, StyleNarrow),
+		},
+	}
+	for lang, caseList := range cases {
+		for expected, humanized := range caseList {
+			if humanized != expected {
+				t.Errorf("%s: expected '%s', got '%s'.", lang, expected, humanized)
+			}
+		}
+	}
+
+	// Styles are used by TimeDiffWith as well.
+	humanizer, _ := New("en")
+	startDate := time.Date(2000, 6, 15, 12, 0, 0, 0, time.UTC)
+	options := DurationOptions{Precise: true, Style: StyleNarrow}
+	humanized := humanizer.TimeDiffWith(startDate, startDate.Add(-duration), options)
+	if humanized != "3h 5m ago" {
+		t.Errorf("Expected '3h 5m ago', got '%s'.", humanized)
+	}
+	parsed, err := humanizer.ParseDuration("3 hrs, 5 min")
+	if err != nil || parsed != duration {
+		t.Errorf("Expected '%s', got '%s' (%v).", duration, parsed, err)
+	}
+}
+
+// Returns the precise duration humanized in the given language and style.
+func humanize(t *testing.T, lang string, duration time.Duration, style Style) string {
+	humanizer, err := New(lang)
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	return humanizer.HumanizeDuration(duration, DurationOptions{Precise: true, Style: style})
+}
+
+func TestHumanizer_HumanizeDuration_RoundTrip(t *testing.T) {
+	// Precise durations have to be parsed back into the same value.
+	durations := []time.Duration{
+		0,
+		time.Second,
+		-90 * time.Second,
+		2*time.Hour + 5*time.Minute,
+		22*Day*time.Second + 21*time.Hour + 350*time.Millisecond,
+		400*Day*time.Second + 5*time.Microsecond + 11*time.Nanosecond,
+		35 * Year * time.Second,
+	}
+	for lang := range languages {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for _, duration := range durations {
+			for _, style := range []Style{StyleLong, StyleShort, StyleNarrow} {
+				humanized := humanizer.HumanizeDuration(duration, DurationOptions{Precise: true, Style: style})
+				parsed, err := humanizer.ParseDuration(humanized)
+				if err != nil {
+					t.Errorf("%s: parsing '%s' failed: %s", lang, humanized, err)
+				} else if parsed != duration {
+					t.Errorf("%s: expected '%s' for '%s', got '%s'.", lang, duration, humanized, parsed)
+				}
+			}
+		}
+	}
+}
+
+func TestHumanizer_TimeDiff_LongTime(t *testing.T) {
+	humanizer, err := New("en")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	startDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	// Times longer than the last range are expressed in its unit.
+	if humanized := humanizer.TimeDiff(startDate, startDate.Add(40*Year*time.Second), false); humanized != "in 40 years" {
+		t.Errorf("Expected 'in 40 years', got '%s'.", humanized)
+	}
+
+	// Dates more than 292 years apart do not fit in time.Duration.
+	endDate := time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		startDate, endDate time.Time
+		options            DurationOptions
+		expected           string
+	}{
+		{startDate, endDate, DurationOptions{}, "in 304 years"},
+		{endDate, startDate, DurationOptions{Precise: true}, "304 years, 4 months and 13 days ago"},
+		{startDate, endDate.Add(time.Nanosecond), DurationOptions{Precise: true, SmallestUnit: Day},
+			"in 304 years, 4 months and 13 days"},
+		{endDate, startDate.Add(-time.Hour), DurationOptions{Precise: true, MaxParts: 2, Rounding: RoundUp},
+			"304 years and 5 months ago"},
+		{startDate, endDate, DurationOptions{Approximation: &DefaultApproximation}, "in over 304 years"},
+	}
+	for _, testCase := range cases {
+		humanized := humanizer.TimeDiffWith(testCase.startDate, testCase.endDate, testCase.options)
+		if humanized != testCase.expected {
+			t.Errorf("Expected '%s', got '%s'.", testCase.expected, humanized)
+		}
+	}
+	label, next := humanizer.TimeDiffNext(endDate, startDate, false)
+	if expected := time.Date(2300, 8, 16, 0, 0, 0, 0, time.UTC); label != "304 years ago" || !next.Equal(expected) {
+		t.Errorf("Expected '304 years ago' until %s, got '%s' until %s.", expected, label, next)
+	}
+}
+
 func TestHumanizer_ParseDuration(t *testing.T) {
 	cases := map[string]map[string]time.Duration{
 		"en": {
@@ -177,6 +843,101 @@ func TestHumanizer_ParseDuration(t *testing.T) {
 			"2 days and then 2 days": time.Duration(4 * Day * time.Second),
 			"-2 days":                time.Duration(-2 * Day * time.Second),
 			"-2 months and 10 days":  time.Duration(-2*Month*time.Second - 10*Day*time.Second),
+			"350 milliseconds":       time.Duration(350 * time.Millisecond),
+			"1.5 ms":                 time.Duration(1500 * time.Microsecond),
+			"20 µs and 5 ns":         time.Duration(20*time.Microsecond + 5*time.Nanosecond),
+			"3 microseconds":         time.Duration(3 * time.Microsecond),
+			"2 seconds and 40us":     time.Duration(2*time.Second + 40*time.Microsecond),
+			"5 Hours":                time.Duration(5 * Hour * time.Second),
+		},
+		"de": {
+			"3 Minuten":            time.Duration(3 * Minute * time.Second),
+			"2,5 Stunden":          time.Duration(2.5 * Hour * time.Second),
+			"70 Tage":              time.Duration(70 * Day * time.Second),
+			"5 Wochen":             time.Duration(5 * Week * time.Second),
+			"3.3 Monate":           time.Duration(3.3 * Month * time.Second),
+			"10 Jahren":            time.Duration(10 * Year * time.Second),
+			"2 Tage und 5 Stunden": time.Duration(2*Day*time.Second + 5*Hour*time.Second),
+			"5 stunden":            time.Duration(5 * Hour * time.Second),
+			"3 MINUTEN":            time.Duration(3 * Minute * time.Second),
+		},
+		"ru": {
+			"3 минуты":           time.Duration(3 * Minute * time.Second),
+			"1 минуту":           time.Duration(1 * Minute * time.Second),
+			"21 секунду":         time.Duration(21 * time.Second),
+			"2.5 часа":           time.Duration(2.5 * Hour * time.Second),
+			"1 день":             time.Duration(1 * Day * time.Second),
+			"70 дней":            time.Duration(70 * Day * time.Second),
+			"1 неделю":           time.Duration(1 * Week * time.Second),
+			"5 недель":           time.Duration(5 * Week * time.Second),
+			"3,3 месяца":         time.Duration(3.3 * Month * time.Second),
+			"10 лет":             time.Duration(10 * Year * time.Second),
+			"2 дня и 5 часов":    time.Duration(2*Day*time.Second + 5*Hour*time.Second),
+			"2 года, 19 месяцев": time.Duration(2*Year*time.Second + 19*Month*time.Second),
+		},
+		"uk": {
+			"3 хвилини":          time.Duration(3 * Minute * time.Second),
+			"1 годину":           time.Duration(1 * Hour * time.Second),
+			"2.5 години":         time.Duration(2.5 * Hour * time.Second),
+			"70 днів":            time.Duration(70 * Day * time.Second),
+			"1 тиждень":          time.Duration(1 * Week * time.Second),
+			"5 тижнів":           time.Duration(5 * Week * time.Second),
+			"1 місяць":           time.Duration(1 * Month * time.Second),
+			"3,3 місяця":         time.Duration(3.3 * Month * time.Second),
+			"1 рік":              time.Duration(1 * Year * time.Second),
+			"10 років":           time.Duration(10 * Year * time.Second),
+			"2 дні і 5 годин":    time.Duration(2*Day*time.Second + 5*Hour*time.Second),
+			"2 роки, 19 місяців": time.Duration(2*Year*time.Second + 19*Month*time.Second),
+		},
+		"fr": {
+			"3 minutes":             time.Duration(3 * Minute * time.Second),
+			"5 secondes":            time.Duration(5 * time.Second),
+			"2,5 heures":            time.Duration(2.5 * Hour * time.Second),
+			"2 jours et 5 heures":   time.Duration(2*Day*time.Second + 5*Hour*time.Second),
+			"3 mois":                time.Duration(3 * Month * time.Second),
+			"2 années":              time.Duration(2 * Year * time.Second),
+			"2 annees et 1 semaine": time.Duration(2*Year*time.Second + Week*time.Second),
+		},
+		"es": {
+			"3 minutos":         time.Duration(3 * Minute * time.Second),
+			"5 segundos":        time.Duration(5 * time.Second),
+			"2 días y 5 horas":  time.Duration(2*Day*time.Second + 5*Hour*time.Second),
+			"2 dias y 5 horas":  time.Duration(2*Day*time.Second + 5*Hour*time.Second),
+			"3 meses":           time.Duration(3 * Month * time.Second),
+			"1 mes y 2 minutos": time.Duration(Month*time.Second + 2*Minute*time.Second),
+			"10 años":           time.Duration(10 * Year * time.Second),
+			"10 anos":           time.Duration(10 * Year * time.Second),
+		},
+		"it": {
+			"3 minuti":          time.Duration(3 * Minute * time.Second),
+			"1 secondo":         time.Duration(1 * time.Second),
+			"2 giorni e 5 ore":  time.Duration(2*Day*time.Second + 5*Hour*time.Second),
+			"1 ora":             time.Duration(Hour * time.Second),
+			"3 mesi e 1 minuto": time.Duration(3*Month*time.Second + Minute*time.Second),
+			"10 anni":           time.Duration(10 * Year * time.Second),
+		},
+		"ja": {
+			"3日と5時間":    time.Duration(3*Day*time.Second + 5*Hour*time.Second),
+			"2.5時間":     time.Duration(2.5 * Hour * time.Second),
+			"3ヶ月":       time.Duration(3 * Month * time.Second),
+			"1週間と10年":   time.Duration(Week*time.Second + 10*Year*time.Second),
+			"3日、5分と1秒後": time.Duration(3*Day*time.Second + 5*Minute*time.Second + time.Second),
+		},
+		"zh": {
+			"3天5小时":    time.Duration(3*Day*time.Second + 5*Hour*time.Second),
+			"2.5小时":    time.Duration(2.5 * Hour * time.Second),
+			"3个月":      time.Duration(3 * Month * time.Second),
+			"2星期":      time.Duration(2 * Week * time.Second),
+			"10年5分钟1秒": time.Duration(10*Year*time.Second + 5*Minute*time.Second + time.Second),
+			"5分鐘30秒":   time.Duration(5*Minute*time.Second + 30*time.Second),
+			"1分钟350毫秒": time.Duration(time.Minute + 350*time.Millisecond),
+		},
+		"ko": {
+			"3일 5시간":      time.Duration(3*Day*time.Second + 5*Hour*time.Second),
+			"2.5시간":       time.Duration(2.5 * Hour * time.Second),
+			"3개월":         time.Duration(3 * Month * time.Second),
+			"2주":          time.Duration(2 * Week * time.Second),
+			"10년 5분 1초 후": time.Duration(10*Year*time.Second + 5*Minute*time.Second + time.Second),
 		},
 		"pl": {
 			"3 minuty":              time.Duration(3 * Minute * time.Second),
@@ -188,6 +949,9 @@ func TestHumanizer_ParseDuration(t *testing.T) {
 			"2 dni i 5 godzin":      time.Duration(2*Day*time.Second + 5*Hour*time.Second),
 			"2 lata, 19 miesięcy":   time.Duration(2*Year*time.Second + 19*Month*time.Second),
 			"2 dni i jeszcze 2 dni": time.Duration(4 * Day * time.Second),
+			"350 milisekund":        time.Duration(350 * time.Millisecond),
+			"2,5 ms":                time.Duration(2500 * time.Microsecond),
+			"3 mikrosekundy":        time.Duration(3 * time.Microsecond),
 		},
 	}
 
@@ -209,18 +973,143 @@ func TestHumanizer_ParseDuration(t *testing.T) {
 	}
 }
 
+func TestHumanizer_ParseDuration_NumberWords(t *testing.T) {
+	cases := map[string]map[string]time.Duration{
+		"en": {
+			"two hours":                   2 * time.Hour,
+			"a minute":                    time.Minute,
+			"half an hour":                30 * time.Minute,
+			"an hour and a half":          90 * time.Minute,
+			"a quarter of an hour":        15 * time.Minute,
+			"a couple of days":            48 * time.Hour,
+			"Twenty-one seconds":          21 * time.Second,
+			"two and a half hours":        150 * time.Minute,
+			"one hour and thirty minutes": 90 * time.Minute,
+			"in a day":                    24 * time.Hour,
+			// Single letter units are whole words, e.g. "dollars" is not "d", so the half is of an hour.
+			"2 hours for dollars and a half": 150 * time.Minute,
+			// Articles are not numbers after a counted unit, e.g. "a day" in "2 hours a day".
+			"2 hours a day":       2 * time.Hour,
+			"two hours a day":     2 * time.Hour,
+			"5 minutes, a second": 5*time.Minute + time.Second,
+			"a day and 2 hours":   26 * time.Hour,
+		},
+		"pl": {
+			"pół godziny":          30 * time.Minute,
+			"dwa dni":              48 * time.Hour,
+			"półtorej godziny":     90 * time.Minute,
+			"dwie i pół minuty":    150 * time.Second,
+			"trzy godziny i pół":   210 * time.Minute,
+			"dwadzieścia pięć lat": time.Duration(25 * Year * time.Second),
+			"parę sekund":          2 * time.Second,
+		},
+	}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for input, expected := range caseList {
+			parsed, err := humanizer.ParseDuration(input)
+			if err != nil {
+				t.Errorf("%s: parsing %q failed with error: %s", lang, input, err)
+			} else if parsed != expected {
+				t.Errorf("%s: expected '%s' for %q, got '%s'.", lang, expected, input, parsed)
+			}
+		}
+	}
+}
+
+func TestHumanizer_ParseDuration_GoSyntax(t *testing.T) {
+	cases := map[string]map[string]time.Duration{
+		"en": {
+			"1h30m":        90 * time.Minute,
+			"90s":          90 * time.Second,
+			"1.5h":         90 * time.Minute,
+			"2 days 3h":    51 * time.Hour,
+			"2d3h":         51 * time.Hour,
+			"1 hr 15m 10s": time.Hour + 15*time.Minute + 10*time.Second,
+			"-1h30m":       -90 * time.Minute,
+		},
+		"pl": {
+			"1h30m":         90 * time.Minute,
+			"2 dni 3h":      51 * time.Hour,
+			"1 godz, 30m":   90 * time.Minute,
+			"2h i pół dnia": 14 * time.Hour,
+			"250ms":         250 * time.Millisecond,
+		},
+		"de": {
+			"2 Tage, 1h30m": 49*time.Hour + 30*time.Minute,
+			"1.5h":          90 * time.Minute,
+		},
+		"ru": {
+			"1h 30m":   90 * time.Minute,
+			"2 дня 3h": 51 * time.Hour,
+		},
+		"ja": {
+			"3日 5h": 77 * time.Hour,
+		},
+	}
+
+	for lang, caseList := range cases {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for input, expected := range caseList {
+			parsed, err := humanizer.ParseDuration(input)
+			if err != nil {
+				t.Errorf("%s: parsing %q failed with error: %s", lang, input, err)
+			} else if parsed != expected {
+				t.Errorf("%s: expected '%s' for %q, got '%s'.", lang, expected, input, parsed)
+			}
+		}
+	}
+}
+
+func TestHumanizer_ParseDuration_AllForms(t *testing.T) {
+	// Every humanized unit form of every language has to be parsed back into the same unit.
+	for lang := range languages {
+		humanizer, err := New(lang)
+		if err != nil {
+			t.Fatalf("Humanizer creation failed with error: %s", err)
+		}
+		for _, unitRanges := range humanizer.provider.times.ranges {
+			for _, count := range []int64{1, 2, 5, 11, 21, 22} {
+				for _, styleForms := range unitRanges.forms {
+					for _, forms := range styleForms {
+						humanized := humanizer.formatCount(count, forms, styleForms[durationStandalone])
+						if !strings.ContainsAny(humanized, "0123456789") {
+							continue // Number is implied or style is not defined, nothing to parse.
+						}
+						expected := time.Duration(count) * unitRanges.divideBy
+						parsed, err := humanizer.ParseDuration(humanized)
+						if err != nil {
+							t.Errorf("%s: parsing '%s' failed: %s", lang, humanized, err)
+						} else if parsed != expected {
+							t.Errorf("%s: expected '%s' for '%s', got '%s'.", lang, expected, humanized, parsed)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
 func TestHumanizer_ParseDuration_Incorrect(t *testing.T) {
 	humanizer, err := New("en")
 	if err != nil {
 		t.Errorf("Humanizer creation failed with error: %s", err)
 	}
-	_, err = humanizer.ParseDuration("wrong duration")
-	if err == nil {
-		t.Error("Humanization succeeded where it should have failed.")
+	for _, input := range []string{"wrong duration", "3 many", "5 hats"} {
+		if _, err = humanizer.ParseDuration(input); err == nil {
+			t.Errorf("Parsing %q succeeded where it should have failed.", input)
+		}
 	}
 }
 
-func TestHumanizer_humanizeDuration(t *testing.T) {
+func TestHumanizer_HumanizeDuration_Seconds(t *testing.T) {
 	humanizer, err := New("en")
 	if err != nil {
 		t.Fatalf("Humanizer creation failed with error: %s", err)
@@ -231,21 +1120,45 @@ func TestHumanizer_humanizeDuration(t *testing.T) {
 		precise  bool
 		expected string
 	}{
-		{0, false, "now"},
-		{0, true, "now"},
+		{0, false, "0 seconds"},
+		{0, true, "0 seconds"},
 		{1, false, "1 second"},
-		{-1, false, "1 second"},
-		{-60, false, "1 minute"},
-		{-3600, false, "1 hour"},
-		{-90, false, "1 minute"},
-		{-90, true, "1 minute and 30 seconds"},
+		{-1, false, "-1 second"},
+		{-60, false, "-1 minute"},
+		{-3600, false, "-1 hour"},
+		{-90, false, "-1 minute"},
+		{-90, true, "-1 minute and 30 seconds"},
 		{90, true, "1 minute and 30 seconds"},
 	}
 
 	for _, tc := range cases {
-		humanized := humanizer.humanizeDuration(tc.seconds, tc.precise)
+		humanized := humanizer.HumanizeDuration(time.Duration(tc.seconds)*time.Second, DurationOptions{Precise: tc.precise})
 		if humanized != tc.expected {
-			t.Errorf("humanizeDuration(%d, %v): expected %q, got %q", tc.seconds, tc.precise, tc.expected, humanized)
+			t.Errorf("HumanizeDuration(%ds, %v): expected %q, got %q", tc.seconds, tc.precise, tc.expected, humanized)
+		}
+	}
+}
+
+func TestHumanizer_TimeDiff_Declension(t *testing.T) {
+	humanizer, err := New("de")
+	if err != nil {
+		t.Fatalf("Humanizer creation failed with error: %s", err)
+	}
+	startDate := time.Date(2000, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]string{
+		humanizer.HumanizeDuration(3*Day*time.Second, DurationOptions{}):                      "3 Tage",
+		humanizer.TimeDiff(startDate, startDate.AddDate(0, 0, 3), false):                      "in 3 Tagen",
+		humanizer.TimeDiff(startDate, startDate.AddDate(0, 0, -3), false):                     "vor 3 Tagen",
+		humanizer.HumanizeDuration(Day*time.Second, DurationOptions{}):                        "1 Tag",
+		humanizer.TimeDiff(startDate, startDate.AddDate(0, 0, 1), false):                      "in 1 Tag",
+		humanizer.HumanizeDuration((2*Year+Hour)*time.Second, DurationOptions{Precise: true}): "2 Jahre und 1 Stunde",
+		humanizer.TimeDiff(startDate, startDate.Add(-(2*Year+Hour)*time.Second), true):        "vor 2 Jahren und 1 Stunde",
+	}
+
+	for humanized, expected := range cases {
+		if humanized != expected {
+			t.Errorf("Expected '%s', got '%s'.", expected, humanized)
 		}
 	}
 }
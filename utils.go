@@ -3,6 +3,11 @@ package humanize
 import (
 	"math/big"
 	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
 // Helper functions.
@@ -30,11 +35,17 @@ func bigPow(x int, y int) *big.Float {
 	return product
 }
 
-// Hack to get rid of trailing zeroes (while keeping the precision if necessary)
-func trimZeroes(value string) string {
-	if strings.ContainsRune(value, '.') {
-		value = strings.TrimRight(value, "0")
-		value = strings.TrimRight(value, ".")
+// Strips the diacritics, so that e.g. "día" and "dia" can be treated alike.
+func foldAccents(value string) string {
+	folder := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(folder, value)
+	if err != nil {
+		return value
 	}
-	return value
+	return folded
+}
+
+// foldUnit returns the input time unit in lower case and without diacritics, e.g. "dia" for "Día".
+func foldUnit(unit string) string {
+	return strings.ToLower(foldAccents(unit))
 }
@@ -22,20 +22,3 @@ func TestBigPow(t *testing.T) {
 		}
 	}
 }
-
-func TestTrimZeros(t *testing.T) {
-	cases := map[string]string{
-		"3.30000":    "3.3",
-		"7seven":     "7seven",
-		"not.number": "not.number",
-		"345.":       "345",
-		"23.00000":   "23",
-	}
-
-	for input, expected := range cases {
-		result := trimZeroes(input)
-		if result != expected {
-			t.Errorf("Expected '%s', got '%s'.", expected, result)
-		}
-	}
-}